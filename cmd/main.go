@@ -4,15 +4,22 @@ import (
 	"log"
 	"newsclip/backend/config"
 	"newsclip/backend/internal/app/models"
+	"newsclip/backend/internal/app/repositories"
 	"newsclip/backend/internal/app/routes"
 	"newsclip/backend/internal/app/services"
 	"newsclip/backend/pkg/redis"
+	"time"
 
 	"github.com/robfig/cron/v3"
 )
 
 // DB 마이그레이션 함수
 func MigrateDB() {
+	// shorts.comment_count 컬럼이 새로 추가되는 경우 기존 댓글 수로 백필 필요
+	needsShortCommentBackfill := !config.DB.Migrator().HasColumn(&models.Short{}, "CommentCount")
+	// news.enrichment_status 컬럼이 새로 추가되는 경우 기존 기사는 보강 완료로 표시
+	needsEnrichmentStatusBackfill := !config.DB.Migrator().HasColumn(&models.News{}, "EnrichmentStatus")
+
 	err := config.DB.AutoMigrate(
 		&models.User{},
 		&models.UserSetting{},
@@ -20,13 +27,13 @@ func MigrateDB() {
 		&models.News{},
 		// &models.NewsLike{},      // [삭제]
 		&models.NewsBookmark{},
-		&models.NewsComment{},
+		// &models.NewsComment{},   // [삭제] -> Comment
 		&models.Short{},
 		// &models.ShortLike{},     // [삭제]
-		&models.ShortComment{},
+		// &models.ShortComment{},  // [삭제] -> Comment
 		&models.Post{},
 		// &models.PostLike{},		// [삭제]
-		&models.PostComment{},
+		// &models.PostComment{},   // [삭제] -> Comment
 		&models.AlertKeyword{},
 		&models.Notification{},
 		&models.Report{},
@@ -39,10 +46,48 @@ func MigrateDB() {
 		&models.ShortInteraction{},
 
 		&models.PostInteraction{},
+		&models.PostEditHistory{},
+		&models.CommentInteraction{},
+		&models.Comment{},
+		&models.ShortView{},
+		&models.NewsNeighbor{},
+		&models.UserMute{},
+		&models.ExperimentExposure{},
+		&models.IngestionRun{},
+		&models.IngestionCategoryRun{},
+		&models.ArchivedNews{},
+		&models.Publisher{},
+		&models.NewsTag{},
+		&models.TrendTopic{},
+		&models.TrendDetectionRun{},
 	)
 	if err != nil {
 		log.Fatal("Failed to migrate database")
 	}
+
+	// 기존 댓글 테이블 -> 통합 댓글 테이블(comments) 이관 (최초 1회)
+	migrated, err := repositories.MigrateLegacyComments()
+	if err != nil {
+		log.Fatalf("Failed to migrate legacy comments: %v", err)
+	}
+	if migrated > 0 {
+		log.Printf("💬 Migrated %d legacy comments into 'comments' table.", migrated)
+	}
+
+	if needsShortCommentBackfill {
+		count, err := repositories.RecountShortCommentCounts()
+		if err != nil {
+			log.Fatalf("Failed to backfill shorts comment_count: %v", err)
+		}
+		log.Printf("🎬 Backfilled comment_count for %d shorts.", count)
+	}
+	if needsEnrichmentStatusBackfill {
+		count, err := repositories.MarkAllNewsEnriched()
+		if err != nil {
+			log.Fatalf("Failed to backfill news enrichment_status: %v", err)
+		}
+		log.Printf("🖼️ Marked %d existing news as enriched.", count)
+	}
 	log.Println("🚀 Database migration completed!")
 }
 
@@ -50,6 +95,9 @@ func MigrateDB() {
 func StartNewsPolling() {
 	log.Println("⏰ Starting background news polling...")
 
+	// 이전 실행 중 서버가 종료되어 'running' 으로 남은 수집 기록 정리
+	services.CloseInterruptedIngestionRuns()
+
 	c := cron.New()
 
 	// 3시간마다 실행
@@ -63,6 +111,15 @@ func StartNewsPolling() {
 			return // 뉴스 수집 실패하면 쇼츠 생성도 중단
 		}
 
+		// 1-1. 이미지/언론사 보강이 끝날 때까지 잠시 대기 (쇼츠 이미지용)
+		if !services.WaitForNewsEnrichment(3 * time.Minute) {
+			log.Println("⚠️ News enrichment still running, generating shorts anyway")
+		}
+
+		// 1-2. 새 기사 자동 태깅 (키워드/개체명) + 급상승 이슈 감지
+		services.TagUntaggedNews()
+		services.DetectTrends()
+
 		// 2. 쇼츠 생성 (뉴스 수집 완료 후 실행)
 		log.Println("🤖 [Cron Job] 2. Generating Shorts...")
 		err = services.GenerateShorts()
@@ -71,23 +128,49 @@ func StartNewsPolling() {
 		}
 	})
 
+	// 30분마다 보강되지 않은 기사(재시작/대기열 초과/일시 오류) 재처리
+	c.AddFunc("@every 30m", services.EnrichPendingNews)
+
+	// 1시간마다 태깅되지 않은 기사(수동 수집/이전 실패분) 태깅 후 급상승 이슈 재계산
+	c.AddFunc("@every 1h", func() {
+		services.TagUntaggedNews()
+		services.DetectTrends()
+	})
+
 	c.Start()
 }
 
-// === 오래된 뉴스 삭제 스케줄러 ===
+// === 오래된 뉴스 보관 처리 스케줄러 ===
 func StartCleanupScheduler() {
 	log.Println("🧹 Starting old news cleanup scheduler...")
 	c := cron.New()
 
 	// "@daily" = 매일 자정 00:00 에 실행
 	c.AddFunc("@daily", func() {
-		log.Println("🌙 [Cleaner Job] Running daily cleanup for news older than 14 days...")
+		log.Println("🌙 [Cleaner Job] Running daily news retention (archive old news)...")
 		services.CleanupOldNews()
 	})
 
 	c.Start()
 }
 
+// === StartNewsNeighborScheduler (연관 기사 계산 스케줄러) ===
+func StartNewsNeighborScheduler() {
+	log.Println("🔗 Starting news neighbor scheduler...")
+
+	// 서버 시작 시 1회 계산 (재시작 직후에도 연관 기사/추천이 동작하도록)
+	services.RebuildNewsNeighbors()
+
+	c := cron.New()
+
+	// 6시간마다 좋아요/북마크 공동 반응 기반 이웃 재계산
+	c.AddFunc("@every 6h", func() {
+		services.RebuildNewsNeighbors()
+	})
+
+	c.Start()
+}
+
 func main() {
 	// 1. 환경 변수 로드
 	config.LoadConfig()
@@ -100,9 +183,13 @@ func main() {
 	// 3. 데이터베이스 마이그레이션 실행
 	MigrateDB()
 
+	// 3-1. 언론사 레지스트리 동기화 (설정 파일 → DB, 기존 뉴스 언론사명 정리)
+	services.SyncPublishers()
+
 	// 4. 스케줄러 시작 (백그라운드)
 	go StartNewsPolling()
 	go StartCleanupScheduler()
+	go StartNewsNeighborScheduler()
 
 	// ==========================================
 	// 5. [테스트용] 서버 시작 시 즉시 1회 실행 로직
@@ -0,0 +1,68 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log"
+	"newsclip/backend/config"
+	"newsclip/backend/internal/app/services"
+	"os"
+)
+
+// === 읽기 시간 일괄 계산 커맨드 ===
+// 원문 본문이 저장된 기사의 read_time_minutes 를 다시 계산하고,
+// 필요하면 원문을 아직 수집하지 않은 기사(-crawl) 또는 이미 수집한 기사(-recrawl)를 크롤링
+//
+//	go run ./cmd/readtime
+//	go run ./cmd/readtime -crawl -crawl-limit 300
+//	go run ./cmd/readtime -dry-run -json
+func main() {
+	batchSize := flag.Int("batch", 500, "재계산 시 1회 조회 개수")
+	crawl := flag.Bool("crawl", false, "원문을 아직 수집하지 않은 기사도 크롤링")
+	recrawl := flag.Bool("recrawl", false, "이미 수집한 기사의 원문을 다시 크롤링")
+	crawlLimit := flag.Int("crawl-limit", 200, "크롤링할 최대 기사 수 (최신순)")
+	dryRun := flag.Bool("dry-run", false, "저장/크롤링 없이 변경 예정 수만 출력")
+	asJSON := flag.Bool("json", false, "JSON 형식으로 출력")
+	flag.Parse()
+
+	if *crawl && *recrawl {
+		log.Fatal("-crawl and -recrawl cannot be used together")
+	}
+
+	// 1. DB 연결 (서버와 같은 .env 사용)
+	config.LoadConfig()
+	config.ConnectDB()
+
+	// 2. 실행
+	report, err := services.BackfillReadTimes(services.ReadTimeBackfillOptions{
+		BatchSize:  *batchSize,
+		Crawl:      *crawl,
+		Recrawl:    *recrawl,
+		CrawlLimit: *crawlLimit,
+		DryRun:     *dryRun,
+	})
+	if err != nil {
+		log.Fatalf("Read time backfill failed: %v", err)
+	}
+
+	// 3. 결과 출력
+	if *asJSON {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(report); err != nil {
+			log.Fatalf("Failed to encode report: %v", err)
+		}
+		return
+	}
+
+	title := "⏱️ Read time backfill"
+	if *dryRun {
+		title += " (dry run)"
+	}
+	fmt.Println(title)
+	fmt.Printf("   crawled      : %d (failed %d, no body %d)\n", report.Crawled, report.CrawlFailed, report.WithoutBody)
+	fmt.Printf("   scanned      : %d\n", report.Scanned)
+	fmt.Printf("   updated      : %d\n", report.Updated)
+	fmt.Printf("   update failed: %d\n", report.UpdateFailed)
+}
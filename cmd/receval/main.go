@@ -0,0 +1,98 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log"
+	"newsclip/backend/config"
+	"newsclip/backend/internal/app/services"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+)
+
+// === 오프라인 추천 평가 커맨드 ===
+// 과거 좋아요/북마크 기록을 분할 시각 기준으로 학습/평가 구간으로 나눈 뒤,
+// 등록된 추천 전략별 precision@K, recall@K, NDCG@K, coverage, 카테고리 다양성을 출력
+//
+//	go run ./cmd/receval -split 2025-11-01 -test-days 7 -k 5
+//	go run ./cmd/receval -strategies hybrid,category -json
+func main() {
+	splitStr := flag.String("split", "", "학습/평가 분할 시각 (YYYY-MM-DD 또는 RFC3339, 기본값: 현재 - test-days)")
+	trainDays := flag.Int("train-days", 30, "학습 구간 길이 (일)")
+	testDays := flag.Int("test-days", 7, "평가 구간 길이 (일)")
+	candidateDays := flag.Int("candidate-days", 7, "분할 시각 며칠 전부터 분할 시각까지 발행된 기사를 후보로 사용할지")
+	k := flag.Int("k", 5, "추천 개수 (K)")
+	strategies := flag.String("strategies", "", "평가할 전략 (쉼표 구분, 기본값: 전체)")
+	asJSON := flag.Bool("json", false, "JSON 형식으로 출력")
+	flag.Parse()
+
+	// 1. 분할 시각 파싱
+	splitAt := time.Now().AddDate(0, 0, -*testDays)
+	if *splitStr != "" {
+		parsed, err := parseSplitTime(*splitStr)
+		if err != nil {
+			log.Fatalf("Invalid -split value: %v", err)
+		}
+		splitAt = parsed
+	}
+
+	var names []string
+	if *strategies != "" {
+		for _, name := range strings.Split(*strategies, ",") {
+			if name = strings.TrimSpace(name); name != "" {
+				names = append(names, name)
+			}
+		}
+	}
+
+	// 2. DB 연결 (서버와 같은 .env 사용)
+	config.LoadConfig()
+	config.ConnectDB()
+
+	// 3. 평가 실행
+	report, err := services.EvaluateRecommenders(services.EvaluationOptions{
+		SplitAt:       splitAt,
+		TrainDays:     *trainDays,
+		TestDays:      *testDays,
+		CandidateDays: *candidateDays,
+		K:             *k,
+		Strategies:    names,
+	})
+	if err != nil {
+		log.Fatalf("Evaluation failed: %v", err)
+	}
+
+	// 4. 결과 출력
+	if *asJSON {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(report); err != nil {
+			log.Fatalf("Failed to encode report: %v", err)
+		}
+		return
+	}
+
+	fmt.Printf("📊 Offline recommender evaluation\n")
+	fmt.Printf("   train: %s ~ %s\n", report.TrainFrom.Format(time.RFC3339), report.SplitAt.Format(time.RFC3339))
+	fmt.Printf("   test : %s ~ %s\n", report.SplitAt.Format(time.RFC3339), report.TestTo.Format(time.RFC3339))
+	fmt.Printf("   K=%d, candidates=%d, users=%d\n\n", report.K, report.PoolSize, report.Users)
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(w, "strategy\tusers\tprecision@%d\trecall@%d\tndcg@%d\tcoverage\tcategory_diversity\n", report.K, report.K, report.K)
+	for _, r := range report.Results {
+		fmt.Fprintf(w, "%s\t%d\t%.4f\t%.4f\t%.4f\t%.4f\t%.4f\n",
+			r.Strategy, r.Users, r.PrecisionAtK, r.RecallAtK, r.NDCGAtK, r.Coverage, r.CategoryDiversity)
+	}
+	w.Flush()
+}
+
+// 분할 시각 파싱 (날짜만 주면 로컬 자정)
+func parseSplitTime(value string) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+	return time.ParseInLocation("2006-01-02", value, time.Local)
+}
@@ -3,6 +3,7 @@ package config
 import (
 	"log"
 	"os"
+	"strconv"
 
 	"github.com/joho/godotenv"
 )
@@ -19,3 +20,18 @@ func LoadConfig() {
 		log.Fatal("Error loading .env file")
 	}
 }
+
+// Env 변수를 실수로 가져오는 함수 (없거나 형식이 잘못되면 기본값)
+func GetEnvFloat(key string, defaultValue float64) float64 {
+	value := os.Getenv(key)
+	if value == "" {
+		return defaultValue
+	}
+
+	parsed, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		log.Printf("⚠️ Invalid float for %s=%q, using default %v", key, value, defaultValue)
+		return defaultValue
+	}
+	return parsed
+}
@@ -5,12 +5,19 @@ import (
 	"newsclip/backend/internal/app/services"
 	"newsclip/backend/internal/app/utils"
 	"strconv"
+	"strings"
 
 	"github.com/gin-gonic/gin"
 )
 
 // 댓글 작성 요청 Body
 type CreateCommentRequest struct {
+	Content  string `json:"content" binding:"required"`
+	ParentID *uint  `json:"parent_id"` // 답글 작성 시 부모 댓글 ID
+}
+
+// 댓글 수정 요청 Body
+type UpdateCommentRequest struct {
 	Content string `json:"content" binding:"required"`
 }
 
@@ -38,6 +45,50 @@ func getTargetID(c *gin.Context) (uint, error) {
 	return uint(id64), nil
 }
 
+// [헬퍼 함수] URL의 :commentId 추출
+func getCommentID(c *gin.Context) (uint, error) {
+	id64, err := strconv.ParseUint(c.Param("commentId"), 10, 32)
+	if err != nil {
+		return 0, err
+	}
+	return uint(id64), nil
+}
+
+// [헬퍼 함수] 커서 페이징 파라미터 (cursorId, size) 파싱
+func getCommentPageParams(c *gin.Context) (uint, int) {
+	var cursorID uint = 0
+	if cursorStr := c.Query("cursorId"); cursorStr != "" {
+		if parsedID, err := strconv.ParseUint(cursorStr, 10, 32); err == nil {
+			cursorID = uint(parsedID)
+		}
+	}
+
+	size, err := strconv.Atoi(c.DefaultQuery("size", "20"))
+	if err != nil || size < 1 {
+		size = 20
+	}
+	if size > 100 { // 안전한 상한값
+		size = 100
+	}
+
+	return cursorID, size
+}
+
+// [헬퍼 함수] 서비스 에러를 HTTP 응답으로 변환
+func sendCommentError(c *gin.Context, err error, defaultMessage string) {
+	msg := err.Error()
+	switch {
+	case msg == "댓글을 찾을 수 없습니다" || msg == "부모 댓글을 찾을 수 없습니다":
+		utils.SendError(c, http.StatusNotFound, msg)
+	case strings.HasPrefix(msg, "본인이 작성한 댓글만"):
+		utils.SendError(c, http.StatusForbidden, msg)
+	case msg == "답글에는 답글을 달 수 없습니다" || msg == "잘못된 대상 타입입니다":
+		utils.SendError(c, http.StatusBadRequest, msg)
+	default:
+		utils.SendError(c, http.StatusInternalServerError, defaultMessage)
+	}
+}
+
 // === 댓글 작성 컨트롤러 ===
 func CreateComment(c *gin.Context) {
 	targetType := c.GetString("targetType")
@@ -65,9 +116,9 @@ func CreateComment(c *gin.Context) {
 	}
 
 	// 4. 서비스 호출
-	commentID, err := services.CreateComment(targetType, targetID, userID, req.Content)
+	commentID, err := services.CreateComment(targetType, targetID, userID, req.Content, req.ParentID)
 	if err != nil {
-		utils.SendError(c, http.StatusInternalServerError, "댓글 작성 실패")
+		sendCommentError(c, err, "댓글 작성 실패")
 		return
 	}
 
@@ -89,9 +140,15 @@ func GetComments(c *gin.Context) {
 		return
 	}
 
-	comments, err := services.GetComments(targetType, targetID)
+	cursorID, size := getCommentPageParams(c)
+	sortBy := c.DefaultQuery("sort", "latest") // best | latest | oldest
+
+	// 사용자 ID 확인 (Optional)
+	userID := c.GetUint("userID")
+
+	comments, err := services.GetComments(targetType, targetID, sortBy, cursorID, size, userID)
 	if err != nil {
-		utils.SendError(c, http.StatusInternalServerError, "댓글 조회 실패")
+		sendCommentError(c, err, "댓글 조회 실패")
 		return
 	}
 
@@ -101,3 +158,141 @@ func GetComments(c *gin.Context) {
 		"data":    comments,
 	})
 }
+
+// === 답글 목록 조회 컨트롤러 ===
+func GetCommentReplies(c *gin.Context) {
+	targetType := c.GetString("targetType")
+
+	targetID, err := getTargetID(c)
+	if err != nil {
+		utils.SendError(c, http.StatusBadRequest, "잘못된 ID입니다.")
+		return
+	}
+	commentID, err := getCommentID(c)
+	if err != nil {
+		utils.SendError(c, http.StatusBadRequest, "잘못된 댓글 ID입니다.")
+		return
+	}
+
+	cursorID, size := getCommentPageParams(c)
+	userID := c.GetUint("userID")
+
+	replies, err := services.GetReplies(targetType, targetID, commentID, cursorID, size, userID)
+	if err != nil {
+		sendCommentError(c, err, "답글 조회 실패")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "답글 목록 조회 성공",
+		"data":    replies,
+	})
+}
+
+// === 댓글 수정 컨트롤러 ===
+func UpdateComment(c *gin.Context) {
+	targetType := c.GetString("targetType")
+
+	targetID, err := getTargetID(c)
+	if err != nil {
+		utils.SendError(c, http.StatusBadRequest, "잘못된 ID입니다.")
+		return
+	}
+	commentID, err := getCommentID(c)
+	if err != nil {
+		utils.SendError(c, http.StatusBadRequest, "잘못된 댓글 ID입니다.")
+		return
+	}
+
+	userID := c.GetUint("userID")
+
+	var req UpdateCommentRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.SendError(c, http.StatusBadRequest, "내용을 입력해주세요.")
+		return
+	}
+
+	comment, err := services.UpdateComment(targetType, targetID, commentID, userID, req.Content)
+	if err != nil {
+		sendCommentError(c, err, "댓글 수정 실패")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "댓글이 수정되었습니다.",
+		"data":    comment,
+	})
+}
+
+// === 댓글 상호작용 컨트롤러 ===
+func InteractComment(c *gin.Context) {
+	targetType := c.GetString("targetType")
+
+	targetID, err := getTargetID(c)
+	if err != nil {
+		utils.SendError(c, http.StatusBadRequest, "잘못된 ID입니다.")
+		return
+	}
+	commentID, err := getCommentID(c)
+	if err != nil {
+		utils.SendError(c, http.StatusBadRequest, "잘못된 댓글 ID입니다.")
+		return
+	}
+
+	userID := c.GetUint("userID")
+
+	// Body 파싱 (news_service에 있는 구조체 재사용)
+	var req services.InteractionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.SendError(c, http.StatusBadRequest, "잘못된 요청 형식입니다.")
+		return
+	}
+
+	if req.InteractionType != "like" && req.InteractionType != "dislike" {
+		utils.SendError(c, http.StatusBadRequest, "interaction_type은 'like' 또는 'dislike'여야 합니다.")
+		return
+	}
+
+	responseDTO, err := services.InteractWithComment(targetType, targetID, commentID, userID, req.InteractionType)
+	if err != nil {
+		sendCommentError(c, err, "상호작용 처리에 실패했습니다.")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "상호작용이 처리되었습니다.",
+		"data":    responseDTO,
+	})
+}
+
+// === 댓글 삭제 컨트롤러 ===
+func DeleteComment(c *gin.Context) {
+	targetType := c.GetString("targetType")
+
+	targetID, err := getTargetID(c)
+	if err != nil {
+		utils.SendError(c, http.StatusBadRequest, "잘못된 ID입니다.")
+		return
+	}
+	commentID, err := getCommentID(c)
+	if err != nil {
+		utils.SendError(c, http.StatusBadRequest, "잘못된 댓글 ID입니다.")
+		return
+	}
+
+	userID := c.GetUint("userID")
+
+	if err := services.DeleteComment(targetType, targetID, commentID, userID); err != nil {
+		sendCommentError(c, err, "댓글 삭제 실패")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "댓글이 삭제되었습니다.",
+		"data":    nil,
+	})
+}
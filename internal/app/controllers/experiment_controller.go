@@ -0,0 +1,45 @@
+package controllers
+
+import (
+	"net/http"
+	"newsclip/backend/internal/app/services"
+	"newsclip/backend/internal/app/utils"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+// [관리자] A/B 실험 목록 조회
+// GET /v1/admin/experiments
+func GetExperiments(c *gin.Context) {
+	c.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "실험 목록 조회 성공",
+		"data":    services.GetExperiments(),
+	})
+}
+
+// [관리자] A/B 실험 변형별 CTR/좋아요율 리포트
+// GET /v1/admin/experiments/:experimentKey/report?days=14
+func GetExperimentReport(c *gin.Context) {
+	days, err := strconv.Atoi(c.DefaultQuery("days", "14"))
+	if err != nil || days < 1 {
+		days = 14
+	}
+
+	report, err := services.GetExperimentReport(c.Param("experimentKey"), days)
+	if err != nil {
+		if err.Error() == "실험을 찾을 수 없습니다" {
+			utils.SendError(c, http.StatusNotFound, err.Error())
+			return
+		}
+		utils.SendError(c, http.StatusInternalServerError, "실험 리포트 조회에 실패했습니다.")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "실험 리포트 조회 성공",
+		"data":    report,
+	})
+}
@@ -0,0 +1,55 @@
+package controllers
+
+import (
+	"net/http"
+	"newsclip/backend/internal/app/services"
+	"newsclip/backend/internal/app/utils"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+// [관리자] 뉴스 수집 실행 기록 조회 (최신순, 카테고리별 결과 포함)
+// GET /v1/admin/ingestion/runs?page=1&size=20
+func GetIngestionRuns(c *gin.Context) {
+	page, err := strconv.Atoi(c.DefaultQuery("page", "1"))
+	if err != nil || page < 1 {
+		page = 1
+	}
+	size, err := strconv.Atoi(c.DefaultQuery("size", "20"))
+	if err != nil || size < 1 || size > 50 {
+		size = 20
+	}
+
+	responseDTO, err := services.GetIngestionRuns(page, size)
+	if err != nil {
+		utils.SendError(c, http.StatusInternalServerError, "수집 기록 조회에 실패했습니다.")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "수집 기록 조회 성공",
+		"data":    responseDTO,
+	})
+}
+
+// [관리자] 뉴스 수집 수동 실행 (백그라운드 실행, 진행 상황은 실행 기록으로 확인)
+// POST /v1/admin/ingestion/runs
+func TriggerIngestionRun(c *gin.Context) {
+	run, err := services.StartManualIngestion()
+	if err != nil {
+		if err.Error() == "이미 수집 작업이 실행 중입니다" {
+			utils.SendError(c, http.StatusConflict, err.Error())
+			return
+		}
+		utils.SendError(c, http.StatusInternalServerError, "수집 실행에 실패했습니다.")
+		return
+	}
+
+	c.JSON(http.StatusAccepted, gin.H{
+		"status":  "success",
+		"message": "뉴스 수집을 시작했습니다.",
+		"data":    run,
+	})
+}
@@ -0,0 +1,93 @@
+package controllers
+
+import (
+	"net/http"
+	"newsclip/backend/internal/app/services"
+	"newsclip/backend/internal/app/utils"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+// "관심 없음" 피드백 요청
+type AddMuteRequest struct {
+	Type  string `json:"type" binding:"required"` // news | source | category | keyword
+	Value string `json:"value" binding:"required"`
+}
+
+// === 뮤트 목록 조회 ===
+// GET /v1/me/mutes
+func GetMyMutes(c *gin.Context) {
+	userID := c.GetUint("userID")
+
+	settings, err := services.GetUserMutes(userID)
+	if err != nil {
+		utils.SendError(c, http.StatusInternalServerError, "뮤트 설정 조회 실패")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "뮤트 설정 조회 성공",
+		"data":    settings,
+	})
+}
+
+// === "관심 없음" 피드백 추가 (기사 숨김 / 언론사·카테고리·키워드 뮤트) ===
+// POST /v1/me/mutes
+func AddMyMute(c *gin.Context) {
+	userID := c.GetUint("userID")
+
+	var req AddMuteRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.SendError(c, http.StatusBadRequest, "type과 value 필드가 필요합니다.")
+		return
+	}
+
+	mute, err := services.AddUserMute(userID, req.Type, req.Value)
+	if err != nil {
+		switch err.Error() {
+		case "뉴스를 찾을 수 없습니다":
+			utils.SendError(c, http.StatusNotFound, err.Error())
+		case "뮤트할 값이 비어 있습니다", "잘못된 뉴스 ID입니다", "키워드는 2자 이상 30자 이하로 입력해주세요",
+			"뮤트할 값이 너무 깁니다", "지원하지 않는 뮤트 종류입니다":
+			utils.SendError(c, http.StatusBadRequest, err.Error())
+		default:
+			utils.SendError(c, http.StatusInternalServerError, "뮤트 설정 저장 실패")
+		}
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{
+		"status":  "success",
+		"message": "관심 없음으로 설정되었습니다.",
+		"data":    mute,
+	})
+}
+
+// === 뮤트 해제 ===
+// DELETE /v1/me/mutes/:muteId
+func RemoveMyMute(c *gin.Context) {
+	userID := c.GetUint("userID")
+
+	muteID64, err := strconv.ParseUint(c.Param("muteId"), 10, 32)
+	if err != nil {
+		utils.SendError(c, http.StatusBadRequest, "잘못된 뮤트 ID입니다.")
+		return
+	}
+
+	if err := services.RemoveUserMute(userID, uint(muteID64)); err != nil {
+		if err.Error() == "뮤트 설정을 찾을 수 없습니다" {
+			utils.SendError(c, http.StatusNotFound, err.Error())
+			return
+		}
+		utils.SendError(c, http.StatusInternalServerError, "뮤트 해제 실패")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "뮤트가 해제되었습니다.",
+		"data":    nil,
+	})
+}
@@ -30,6 +30,17 @@ func GetNewsList(c *gin.Context) {
 		size = 10
 	}
 
+	// [신규] 언론사 필터 (GET /v1/publishers 의 id)
+	var publisherID uint = 0
+	if publisherStr := c.Query("publisherId"); publisherStr != "" {
+		parsed, err := strconv.ParseUint(publisherStr, 10, 32)
+		if err != nil {
+			utils.SendError(c, http.StatusBadRequest, "잘못된 언론사 ID입니다.")
+			return
+		}
+		publisherID = uint(parsed)
+	}
+
 	// 2. (선택적) 사용자 ID 가져오기 (로그인 상태일 수 있으므로)
 	// (AuthMiddlewareOptional() 같은 미들웨어가 필요하지만,
 	//  우선 GetMyProfile 등에서 사용한 'c.Get("userID")'를 사용)
@@ -41,7 +52,7 @@ func GetNewsList(c *gin.Context) {
 	}
 
 	// 3. 서비스 호출
-	responseDTO, err := services.GetNewsList(category, page, size, userID)
+	responseDTO, err := services.GetNewsList(category, page, size, userID, publisherID)
 	if err != nil {
 		utils.SendError(c, http.StatusInternalServerError, "뉴스 조회에 실패했습니다.")
 		return
@@ -183,3 +194,67 @@ func BookmarkNews(c *gin.Context) {
 		},
 	})
 }
+
+// === 연관 뉴스 조회 컨트롤러 ===
+// GET /v1/news/:newsId/related?size=10
+func GetRelatedNews(c *gin.Context) {
+	// 1. URL 파라미터에서 newsId 추출
+	newsIDStr := c.Param("newsId")
+	newsID64, err := strconv.ParseUint(newsIDStr, 10, 32)
+	if err != nil {
+		utils.SendError(c, http.StatusBadRequest, "잘못된 뉴스 ID입니다.")
+		return
+	}
+	newsID := uint(newsID64)
+
+	// 2. size 파싱 (서비스에서 기본값/상한 처리)
+	size, _ := strconv.Atoi(c.DefaultQuery("size", "10"))
+
+	// 3. 서비스 호출
+	responseDTO, err := services.GetRelatedNews(newsID, size)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			utils.SendError(c, http.StatusNotFound, "해당 뉴스를 찾을 수 없습니다.")
+			return
+		}
+		utils.SendError(c, http.StatusInternalServerError, "연관 뉴스 조회에 실패했습니다.")
+		return
+	}
+
+	// 4. 성공 응답
+	c.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "연관 뉴스 조회 성공",
+		"data":    responseDTO,
+	})
+}
+
+// === 인기 급상승(트렌딩) 뉴스 조회 컨트롤러 ===
+// GET /v1/news/trending?category=기술&size=10 (비로그인 가능)
+func GetTrendingNews(c *gin.Context) {
+	// 1. 쿼리 파라미터 파싱 (서비스에서 기본값/상한 처리)
+	category := c.DefaultQuery("category", "전체")
+	size, _ := strconv.Atoi(c.DefaultQuery("size", "10"))
+
+	// 2. (선택적) 사용자 ID (로그인 시 뮤트 설정 반영)
+	var userID uint = 0
+	if userIDValue, exists := c.Get("userID"); exists {
+		if id, ok := userIDValue.(uint); ok {
+			userID = id
+		}
+	}
+
+	// 3. 서비스 호출
+	responseDTO, err := services.GetTrendingNews(category, size, userID)
+	if err != nil {
+		utils.SendError(c, http.StatusInternalServerError, "인기 뉴스 조회에 실패했습니다.")
+		return
+	}
+
+	// 4. 성공 응답
+	c.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "인기 뉴스 조회 성공",
+		"data":    responseDTO,
+	})
+}
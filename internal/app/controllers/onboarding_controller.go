@@ -0,0 +1,75 @@
+package controllers
+
+import (
+	"net/http"
+	"newsclip/backend/internal/app/services"
+	"newsclip/backend/internal/app/utils"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// 온보딩 완료 요청
+type CompleteOnboardingRequest struct {
+	Categories  []string `json:"categories" binding:"required"`
+	SeedNewsIDs []uint   `json:"seed_news_ids"`
+}
+
+// === 온보딩 시드 기사 조회 ===
+// GET /v1/onboarding/seeds?categories=기술,경제
+func GetOnboardingSeeds(c *gin.Context) {
+	var categories []string
+	if raw := c.Query("categories"); raw != "" {
+		for _, category := range strings.Split(raw, ",") {
+			if category = strings.TrimSpace(category); category != "" {
+				categories = append(categories, category)
+			}
+		}
+	}
+
+	resp, err := services.GetOnboardingSeeds(categories)
+	if err != nil {
+		if strings.HasPrefix(err.Error(), "지원하지 않는 카테고리입니다") {
+			utils.SendError(c, http.StatusBadRequest, err.Error())
+			return
+		}
+		utils.SendError(c, http.StatusInternalServerError, "온보딩 기사 조회에 실패했습니다.")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "온보딩 기사 조회 성공",
+		"data":    resp,
+	})
+}
+
+// === 온보딩 완료 (선호 카테고리 + 시드 기사 좋아요) ===
+// POST /v1/me/onboarding
+func CompleteOnboarding(c *gin.Context) {
+	userID := c.GetUint("userID")
+
+	var req CompleteOnboardingRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.SendError(c, http.StatusBadRequest, "categories 필드가 필요합니다.")
+		return
+	}
+
+	result, err := services.CompleteOnboarding(userID, req.Categories, req.SeedNewsIDs)
+	if err != nil {
+		msg := err.Error()
+		if msg == "카테고리를 하나 이상 선택해주세요" || msg == "선택할 수 있는 기사 수를 초과했습니다" ||
+			strings.HasPrefix(msg, "지원하지 않는 카테고리입니다") {
+			utils.SendError(c, http.StatusBadRequest, msg)
+			return
+		}
+		utils.SendError(c, http.StatusInternalServerError, "온보딩 저장에 실패했습니다.")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "온보딩이 완료되었습니다.",
+		"data":    result,
+	})
+}
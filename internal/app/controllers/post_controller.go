@@ -38,6 +38,38 @@ func GetCommunityPosts(c *gin.Context) {
 	})
 }
 
+// [헬퍼 함수] multipart "files" 필드의 이미지를 저장하고 접근 URL 목록을 반환
+// (두 번째 반환값: 요청에 files 필드가 포함되어 있었는지 여부)
+func savePostImages(c *gin.Context) ([]string, bool, error) {
+	var imageURLs []string
+
+	form, err := c.MultipartForm()
+	if err != nil || form.File == nil {
+		return imageURLs, false, nil
+	}
+
+	files, exists := form.File["files"]
+	if !exists {
+		return imageURLs, false, nil
+	}
+
+	uploadDir := "uploads/posts"
+	os.MkdirAll(uploadDir, os.ModePerm)
+
+	for _, file := range files {
+		filePath := uploadDir + "/" + file.Filename
+
+		if err := c.SaveUploadedFile(file, filePath); err != nil {
+			return nil, true, err
+		}
+
+		imageURLs = append(imageURLs,
+			"https://newsclip.duckdns.org/v1/"+filePath)
+	}
+
+	return imageURLs, true, nil
+}
+
 func CreatePost(c *gin.Context) {
 	userID := c.GetUint("userID")
 
@@ -51,26 +83,10 @@ func CreatePost(c *gin.Context) {
 	}
 
 	// 이미지 업로드
-	form, err := c.MultipartForm()
-	var imageURLs []string
-
-	if err == nil && form.File != nil {
-		files := form.File["files"]
-
-		uploadDir := "uploads/posts"
-		os.MkdirAll(uploadDir, os.ModePerm)
-
-		for _, file := range files {
-			filePath := uploadDir + "/" + file.Filename
-
-			if err := c.SaveUploadedFile(file, filePath); err != nil {
-				utils.SendError(c, http.StatusInternalServerError, "이미지 저장 실패")
-				return
-			}
-
-			imageURLs = append(imageURLs,
-				"https://newsclip.duckdns.org/v1/"+filePath)
-		}
+	imageURLs, _, err := savePostImages(c)
+	if err != nil {
+		utils.SendError(c, http.StatusInternalServerError, "이미지 저장 실패")
+		return
 	}
 
 	// 서비스 호출
@@ -87,6 +103,139 @@ func CreatePost(c *gin.Context) {
 	})
 }
 
+// === 게시글 상세 조회 컨트롤러 ===
+func GetPostDetail(c *gin.Context) {
+	postIDStr := c.Param("postId")
+	postID64, err := strconv.ParseUint(postIDStr, 10, 32)
+	if err != nil {
+		utils.SendError(c, http.StatusBadRequest, "잘못된 게시글 ID입니다.")
+		return
+	}
+	postID := uint(postID64)
+
+	// 사용자 ID 확인 (Optional)
+	userID := c.GetUint("userID")
+
+	response, err := services.GetPostDetail(postID, userID, c.ClientIP())
+	if err != nil {
+		if err.Error() == "게시글을 찾을 수 없습니다" {
+			utils.SendError(c, http.StatusNotFound, err.Error())
+			return
+		}
+		utils.SendError(c, http.StatusInternalServerError, "게시글 조회에 실패했습니다.")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "게시글 조회 성공",
+		"data":    response,
+	})
+}
+
+// === 게시글 수정 컨트롤러 ===
+// (multipart/form-data: title, content, category, files - 보낸 필드만 수정)
+// (files를 보내면 기존 이미지를 교체, clear_images=true면 이미지 전체 삭제)
+func UpdateMyPost(c *gin.Context) {
+	userID := c.GetUint("userID")
+
+	postIDStr := c.Param("postId")
+	postID64, err := strconv.ParseUint(postIDStr, 10, 32)
+	if err != nil {
+		utils.SendError(c, http.StatusBadRequest, "잘못된 게시글 ID입니다.")
+		return
+	}
+	postID := uint(postID64)
+
+	var input services.UpdatePostInput
+
+	if title, exists := c.GetPostForm("title"); exists {
+		if title == "" {
+			utils.SendError(c, http.StatusBadRequest, "title은 비워둘 수 없습니다.")
+			return
+		}
+		input.Title = &title
+	}
+	if content, exists := c.GetPostForm("content"); exists {
+		if content == "" {
+			utils.SendError(c, http.StatusBadRequest, "content는 비워둘 수 없습니다.")
+			return
+		}
+		input.Content = &content
+	}
+	if category, exists := c.GetPostForm("category"); exists {
+		input.Category = &category
+	}
+
+	// 이미지 교체
+	imageURLs, hasFiles, err := savePostImages(c)
+	if err != nil {
+		utils.SendError(c, http.StatusInternalServerError, "이미지 저장 실패")
+		return
+	}
+	if hasFiles || c.PostForm("clear_images") == "true" {
+		input.ReplaceImages = true
+		input.ImageURLs = imageURLs
+	}
+
+	response, err := services.UpdateMyPost(userID, postID, input)
+	if err != nil {
+		switch err.Error() {
+		case "게시글을 찾을 수 없습니다":
+			utils.SendError(c, http.StatusNotFound, err.Error())
+		case "본인이 작성한 게시글만 수정할 수 있습니다":
+			utils.SendError(c, http.StatusForbidden, err.Error())
+		case "변경할 정보가 없습니다":
+			utils.SendError(c, http.StatusBadRequest, err.Error())
+		default:
+			utils.SendError(c, http.StatusInternalServerError, "게시글 수정 실패")
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "게시글이 수정되었습니다.",
+		"data":    response,
+	})
+}
+
+// === 게시글 수정 이력 조회 컨트롤러 (작성자/관리자 전용) ===
+func GetPostEditHistory(c *gin.Context) {
+	userIDValue, exists := c.Get("userID")
+	if !exists {
+		utils.SendError(c, http.StatusUnauthorized, "인증 정보가 없습니다.")
+		return
+	}
+	userID, _ := userIDValue.(uint)
+
+	postIDStr := c.Param("postId")
+	postID64, err := strconv.ParseUint(postIDStr, 10, 32)
+	if err != nil {
+		utils.SendError(c, http.StatusBadRequest, "잘못된 게시글 ID입니다.")
+		return
+	}
+
+	histories, err := services.GetPostEditHistory(userID, uint(postID64))
+	if err != nil {
+		switch err.Error() {
+		case "게시글을 찾을 수 없습니다":
+			utils.SendError(c, http.StatusNotFound, err.Error())
+		case "수정 이력은 작성자 또는 관리자만 조회할 수 있습니다":
+			utils.SendError(c, http.StatusForbidden, err.Error())
+		default:
+			utils.SendError(c, http.StatusInternalServerError, "수정 이력 조회 실패")
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "수정 이력 조회 성공",
+		"data":    gin.H{"histories": histories},
+	})
+}
+
 // === 게시글 상호작용 컨트롤러 ===
 func InteractPost(c *gin.Context) {
 	// 1. UserID 확인
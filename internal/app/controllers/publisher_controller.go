@@ -0,0 +1,25 @@
+package controllers
+
+import (
+	"net/http"
+	"newsclip/backend/internal/app/services"
+	"newsclip/backend/internal/app/utils"
+
+	"github.com/gin-gonic/gin"
+)
+
+// === 언론사 목록 조회 ===
+// GET /v1/publishers
+func GetPublishers(c *gin.Context) {
+	publishers, err := services.GetPublishers()
+	if err != nil {
+		utils.SendError(c, http.StatusInternalServerError, "언론사 목록 조회에 실패했습니다.")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "언론사 목록 조회 성공",
+		"data":    publishers,
+	})
+}
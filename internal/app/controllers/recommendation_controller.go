@@ -47,3 +47,41 @@ func GetRecommendedNews(c *gin.Context) {
 		"data":    resp,
 	})
 }
+
+// [관리자] 추천 점수 상세(디버그) 조회 컨트롤러
+// GET /v1/admin/recommendations/debug?userId=1&count=5
+func GetRecommendationDebug(c *gin.Context) {
+	// 1. 대상 사용자 (없으면 요청한 관리자 본인)
+	userID := c.GetUint("userID")
+	if userIDStr := c.Query("userId"); userIDStr != "" {
+		parsed, err := strconv.ParseUint(userIDStr, 10, 32)
+		if err != nil {
+			utils.SendError(c, http.StatusBadRequest, "잘못된 사용자 ID입니다.")
+			return
+		}
+		userID = uint(parsed)
+	}
+
+	// 2. 추천 개수(count) 파라미터 파싱 (기본값: 5)
+	count, err := strconv.Atoi(c.DefaultQuery("count", "5"))
+	if err != nil || count < 1 {
+		count = 5
+	}
+	if count > 50 {
+		count = 50
+	}
+
+	// 3. 서비스 호출
+	resp, err := services.GetRecommendationDebug(userID, count)
+	if err != nil {
+		utils.SendError(c, http.StatusInternalServerError, "추천 디버그 정보 조회에 실패했습니다.")
+		return
+	}
+
+	// 4. 응답
+	c.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "추천 디버그 정보 조회 성공",
+		"data":    resp,
+	})
+}
@@ -0,0 +1,27 @@
+package controllers
+
+import (
+	"net/http"
+	"newsclip/backend/internal/app/services"
+	"newsclip/backend/internal/app/utils"
+
+	"github.com/gin-gonic/gin"
+)
+
+// [관리자] 뉴스 보관 정책 수동 실행
+// POST /v1/admin/retention/run?dryRun=true (dryRun 이면 대상 수만 집계)
+func RunNewsRetention(c *gin.Context) {
+	dryRun := c.DefaultQuery("dryRun", "false") == "true"
+
+	report, err := services.RunNewsRetention(dryRun)
+	if err != nil {
+		utils.SendError(c, http.StatusInternalServerError, "뉴스 보관 처리에 실패했습니다.")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "뉴스 보관 처리 완료",
+		"data":    report,
+	})
+}
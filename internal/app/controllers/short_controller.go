@@ -1,12 +1,14 @@
 package controllers
 
 import (
+	"errors"
 	"net/http"
 	"newsclip/backend/internal/app/services"
 	"newsclip/backend/internal/app/utils"
 	"strconv"
 
 	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
 )
 
 // === 쇼츠 피드 조회 ===
@@ -39,8 +41,19 @@ func GetShortsFeed(c *gin.Context) {
 	}
 
 	// 4. 서비스 호출 (cursorID 전달)
-	shortsFeed, err := services.GetShortsFeed(size, cursorID, userID)
+	// mode=for_you 이면 사용자 맞춤 순서, 그 외에는 최신순
+	var shortsFeed []services.ShortFeedItemDTO
+	if c.DefaultQuery("mode", "latest") == "for_you" {
+		shortsFeed, err = services.GetPersonalizedShortsFeed(size, cursorID, userID)
+	} else {
+		shortsFeed, err = services.GetShortsFeed(size, cursorID, userID)
+	}
 	if err != nil {
+		// [신규] 맞춤 피드 스냅샷 만료 → 410 (클라이언트는 cursorId 없이 처음부터 다시 요청)
+		if errors.Is(err, services.ErrShortsFeedExpired) {
+			utils.SendError(c, http.StatusGone, "맞춤 피드가 갱신되었습니다. 처음부터 다시 불러와 주세요.")
+			return
+		}
 		utils.SendError(c, http.StatusInternalServerError, "쇼츠 피드 조회 실패")
 		return
 	}
@@ -53,6 +66,44 @@ func GetShortsFeed(c *gin.Context) {
 	})
 }
 
+// === 쇼츠 상세 조회 ===
+func GetShortDetail(c *gin.Context) {
+	// 1. ShortID 파싱
+	shortIDStr := c.Param("shortId")
+	shortID64, err := strconv.ParseUint(shortIDStr, 10, 32)
+	if err != nil {
+		utils.SendError(c, http.StatusBadRequest, "잘못된 쇼츠 ID입니다.")
+		return
+	}
+	shortID := uint(shortID64)
+
+	// 2. 사용자 ID 확인 (Optional)
+	var userID uint = 0
+	if userIDValue, exists := c.Get("userID"); exists {
+		if id, ok := userIDValue.(uint); ok {
+			userID = id
+		}
+	}
+
+	// 3. 서비스 호출
+	responseDTO, err := services.GetShortDetail(shortID, userID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			utils.SendError(c, http.StatusNotFound, "해당 쇼츠를 찾을 수 없습니다.")
+			return
+		}
+		utils.SendError(c, http.StatusInternalServerError, "쇼츠 조회 실패")
+		return
+	}
+
+	// 4. 응답
+	c.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "쇼츠 조회 성공",
+		"data":    responseDTO,
+	})
+}
+
 // === 쇼츠 상호작용 컨트롤러 ===
 func InteractShort(c *gin.Context) {
 	// 1. UserID 확인 (AuthMiddleware 필수)
@@ -98,3 +149,46 @@ func InteractShort(c *gin.Context) {
 		"data":    responseDTO,
 	})
 }
+
+// === 쇼츠 시청 이벤트 수집 요청 ===
+type ShortViewEventsRequest struct {
+	Events []services.ShortViewEvent `json:"events" binding:"required"`
+}
+
+// === 쇼츠 시청 이벤트 일괄 수집 ===
+// 클라이언트가 모아둔 노출/시청 시간 이벤트를 한 번에 전송
+func RecordShortViewEvents(c *gin.Context) {
+	// 1. 사용자 ID 확인 (Optional)
+	var userID uint = 0
+	if userIDValue, exists := c.Get("userID"); exists {
+		if id, ok := userIDValue.(uint); ok {
+			userID = id
+		}
+	}
+
+	// 2. Body 파싱
+	var req ShortViewEventsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.SendError(c, http.StatusBadRequest, "잘못된 요청 형식입니다.")
+		return
+	}
+
+	// 3. 서비스 호출
+	result, err := services.RecordShortViewEvents(userID, c.ClientIP(), req.Events)
+	if err != nil {
+		switch err.Error() {
+		case "이벤트가 비어 있습니다", "한 번에 보낼 수 있는 이벤트 수를 초과했습니다":
+			utils.SendError(c, http.StatusBadRequest, err.Error())
+		default:
+			utils.SendError(c, http.StatusInternalServerError, "시청 이벤트 저장 실패")
+		}
+		return
+	}
+
+	// 4. 응답
+	c.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "시청 이벤트가 저장되었습니다.",
+		"data":    result,
+	})
+}
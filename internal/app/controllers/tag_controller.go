@@ -0,0 +1,53 @@
+package controllers
+
+import (
+	"net/http"
+	"newsclip/backend/internal/app/services"
+	"newsclip/backend/internal/app/utils"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// === 태그별 뉴스 목록 조회 ===
+// GET /v1/tags/:tag/news?page=1&size=10
+func GetNewsByTag(c *gin.Context) {
+	// 1. 파라미터 파싱
+	tag := strings.TrimSpace(c.Param("tag"))
+	if tag == "" {
+		utils.SendError(c, http.StatusBadRequest, "태그가 비어 있습니다.")
+		return
+	}
+
+	page, err := strconv.Atoi(c.DefaultQuery("page", "1"))
+	if err != nil || page < 1 {
+		page = 1
+	}
+	size, err := strconv.Atoi(c.DefaultQuery("size", "10"))
+	if err != nil || size < 1 {
+		size = 10
+	}
+
+	// 2. (선택적) 사용자 ID (뮤트 설정 반영)
+	var userID uint = 0
+	if userIDValue, exists := c.Get("userID"); exists {
+		if id, ok := userIDValue.(uint); ok {
+			userID = id
+		}
+	}
+
+	// 3. 서비스 호출
+	responseDTO, err := services.GetNewsByTag(tag, page, size, userID)
+	if err != nil {
+		utils.SendError(c, http.StatusInternalServerError, "태그 뉴스 조회에 실패했습니다.")
+		return
+	}
+
+	// 4. 성공 응답
+	c.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "태그 뉴스 조회 성공",
+		"data":    responseDTO,
+	})
+}
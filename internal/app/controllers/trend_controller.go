@@ -0,0 +1,39 @@
+package controllers
+
+import (
+	"net/http"
+	"newsclip/backend/internal/app/services"
+	"newsclip/backend/internal/app/utils"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+// === 지금 뜨는 이슈 (급상승 토픽) 조회 ===
+// GET /v1/trends?size=10
+func GetTrends(c *gin.Context) {
+	size, err := strconv.Atoi(c.DefaultQuery("size", "10"))
+	if err != nil || size < 1 {
+		size = 10
+	}
+
+	// (선택적) 사용자 ID (뮤트한 기사는 대표 기사에서 제외)
+	var userID uint = 0
+	if userIDValue, exists := c.Get("userID"); exists {
+		if id, ok := userIDValue.(uint); ok {
+			userID = id
+		}
+	}
+
+	responseDTO, err := services.GetTrends(size, userID)
+	if err != nil {
+		utils.SendError(c, http.StatusInternalServerError, "급상승 이슈 조회에 실패했습니다.")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "급상승 이슈 조회 성공",
+		"data":    responseDTO,
+	})
+}
@@ -2,6 +2,7 @@ package middlewares
 
 import (
 	"net/http"
+	"newsclip/backend/internal/app/repositories"
 	"newsclip/backend/internal/app/utils"
 	"strings"
 
@@ -65,3 +66,17 @@ func AuthMiddlewareOptional() gin.HandlerFunc {
 		c.Next()
 	}
 }
+
+// AdminMiddleware는 관리자(role = 'admin')만 통과시킵니다. (AuthMiddleware 뒤에 사용)
+func AdminMiddleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		user, err := repositories.FindUserByID(c.GetUint("userID"))
+		if err != nil || user.Role != "admin" {
+			utils.SendError(c, http.StatusForbidden, "관리자 권한이 필요합니다.")
+			c.Abort()
+			return
+		}
+
+		c.Next()
+	}
+}
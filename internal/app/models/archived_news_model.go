@@ -0,0 +1,17 @@
+package models
+
+import "time"
+
+// ArchivedNews: 보관 기간이 지난 뉴스의 요약 보관본 (archived_news)
+// 원본 뉴스 ID를 그대로 사용하므로 알림 딥링크 등 기존 링크로 계속 찾을 수 있음
+type ArchivedNews struct {
+	ID          uint      `gorm:"primaryKey;autoIncrement:false" json:"id"` // 원본 news.id
+	ExternalID  string    `gorm:"type:varchar(255);index" json:"external_id"`
+	Title       string    `gorm:"type:text;not null" json:"title"`
+	URL         string    `gorm:"type:text" json:"url"`
+	Source      string    `gorm:"type:text" json:"source"`
+	Category    string    `gorm:"type:varchar(50)" json:"category"`
+	PublishedAt time.Time `json:"published_at"`
+	CreatedAt   time.Time `json:"created_at"`               // 원본 수집 시각
+	ArchivedAt  time.Time `gorm:"index" json:"archived_at"` // 보관 처리 시각
+}
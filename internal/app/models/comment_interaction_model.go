@@ -0,0 +1,12 @@
+package models
+
+import "time"
+
+// CommentInteraction: 댓글 좋아요/싫어요 (뉴스/쇼츠/게시글 댓글 공통)
+type CommentInteraction struct {
+	UserID          uint   `gorm:"primaryKey"`
+	TargetType      string `gorm:"type:varchar(10);primaryKey"` // 'news', 'short', 'post'
+	CommentID       uint   `gorm:"primaryKey"`
+	InteractionType string `gorm:"type:varchar(10);not null"` // 'like' or 'dislike'
+	CreatedAt       time.Time
+}
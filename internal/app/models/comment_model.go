@@ -0,0 +1,29 @@
+package models
+
+import "time"
+
+// Comment: 통합 댓글 (comments)
+// (target_type, target_id)로 대상(뉴스/쇼츠/게시글)을 구분하므로
+// 댓글을 달 수 있는 대상이 늘어나도 테이블을 새로 만들 필요가 없음
+type Comment struct {
+	ID         uint   `gorm:"primaryKey" json:"id"`
+	TargetType string `gorm:"type:varchar(10);not null;index:idx_comments_target" json:"target_type"` // 'news', 'short', 'post'
+	TargetID   uint   `gorm:"not null;index:idx_comments_target" json:"target_id"`
+	UserID     uint   `gorm:"not null;index" json:"user_id"`
+	Content    string `gorm:"type:text;not null" json:"content"`
+
+	// 답글/수정/삭제
+	ParentID   *uint      `gorm:"index" json:"parent_id"`          // 답글이면 부모 댓글 ID (1단계만 허용)
+	ReplyCount int        `gorm:"default:0" json:"reply_count"`    // 삭제되지 않은 답글 수 (캐시)
+	IsDeleted  bool       `gorm:"default:false" json:"is_deleted"` // 삭제 여부 (답글이 남아있으면 자리표시자로 노출)
+	EditedAt   *time.Time `json:"edited_at"`
+
+	// 좋아요/싫어요 캐시
+	LikeCount    int `gorm:"default:0" json:"like_count"`
+	DislikeCount int `gorm:"default:0" json:"dislike_count"`
+
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+
+	User User `json:"user"` // 댓글 작성자 정보 포함
+}
@@ -0,0 +1,16 @@
+package models
+
+import "time"
+
+// ExperimentExposure: A/B 실험 노출 기록
+// (실험별 사용자-아이템 쌍당 최초 노출 1건만 저장, 이후 클릭 시 ClickedAt 기록)
+type ExperimentExposure struct {
+	ID            uint       `gorm:"primaryKey"`
+	ExperimentKey string     `gorm:"type:varchar(50);not null;uniqueIndex:idx_experiment_exposure_unique;index:idx_experiment_exposure_report"`
+	Variant       string     `gorm:"type:varchar(50);not null;index:idx_experiment_exposure_report"`
+	UserID        uint       `gorm:"not null;uniqueIndex:idx_experiment_exposure_unique"`
+	ItemType      string     `gorm:"type:varchar(10);not null;uniqueIndex:idx_experiment_exposure_unique"` // 'news' or 'short'
+	ItemID        uint       `gorm:"not null;uniqueIndex:idx_experiment_exposure_unique"`
+	ClickedAt     *time.Time // 노출 후 상세 조회/시청한 시각
+	CreatedAt     time.Time
+}
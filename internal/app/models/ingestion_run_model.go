@@ -0,0 +1,41 @@
+package models
+
+import "time"
+
+// 수집 실행 상태 값
+const (
+	IngestionRunning = "running"
+	IngestionSuccess = "success" // 모든 카테고리 성공
+	IngestionPartial = "partial" // 일부 카테고리 실패
+	IngestionFailed  = "failed"  // 모든 카테고리 실패 (또는 실행 중 서버 종료)
+)
+
+// IngestionRun: 뉴스 수집 실행 기록 (FetchAllCategories 1회 = 1건)
+type IngestionRun struct {
+	ID               uint       `gorm:"primaryKey" json:"id"`
+	Trigger          string     `gorm:"type:varchar(20);not null" json:"trigger"` // 'schedule' or 'manual'
+	Status           string     `gorm:"type:varchar(20);not null;index" json:"status"`
+	StartedAt        time.Time  `gorm:"index" json:"started_at"`
+	FinishedAt       *time.Time `json:"finished_at"`
+	Fetched          int        `gorm:"default:0" json:"fetched"`    // 네이버 API에서 받은 기사 수
+	Duplicates       int        `gorm:"default:0" json:"duplicates"` // 이미 저장되어 있던 기사 수
+	Stored           int        `gorm:"default:0" json:"stored"`     // 새로 저장한 기사 수
+	EnrichmentFailed int        `gorm:"default:0" json:"enrichment_failed"`
+	ErrorCount       int        `gorm:"default:0" json:"error_count"` // 실패한 카테고리 수
+
+	Categories []IngestionCategoryRun `gorm:"foreignKey:RunID;constraint:OnDelete:CASCADE" json:"categories"`
+}
+
+// IngestionCategoryRun: 수집 실행 내 카테고리별 결과
+type IngestionCategoryRun struct {
+	ID               uint       `gorm:"primaryKey" json:"id"`
+	RunID            uint       `gorm:"not null;index" json:"-"`
+	Category         string     `gorm:"type:varchar(50);not null" json:"category"`
+	StartedAt        time.Time  `json:"started_at"`
+	FinishedAt       *time.Time `json:"finished_at"`
+	Fetched          int        `gorm:"default:0" json:"fetched"`
+	Duplicates       int        `gorm:"default:0" json:"duplicates"`
+	Stored           int        `gorm:"default:0" json:"stored"`
+	EnrichmentFailed int        `gorm:"default:0" json:"enrichment_failed"` // 비동기 보강 중 실패 (수집 종료 후에도 증가할 수 있음)
+	Error            string     `gorm:"type:text" json:"error,omitempty"`
+}
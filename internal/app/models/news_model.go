@@ -2,8 +2,6 @@ package models
 
 import (
 	"time"
-
-	"gorm.io/gorm"
 )
 
 // News: 뉴스 본문 데이터 (news)
@@ -14,7 +12,9 @@ type News struct {
 	Title       string    `gorm:"type:text;not null" json:"title"`
 	Content     string    `gorm:"type:text" json:"content"`
 	Source      string    `gorm:"type:text" json:"source"`
+	PublisherID *uint     `gorm:"index" json:"publisher_id"` // [신규] 언론사 레지스트리 매칭 결과 (없으면 null)
 	URL         string    `gorm:"type:text" json:"url"`
+	OriginalURL string    `gorm:"type:text" json:"-"` // [신규] 언론사 원문 링크 (메타데이터 보강용)
 	Category    string    `gorm:"type:varchar(50)" json:"category"`
 	ImageURL    string    `gorm:"type:text" json:"image_url"`
 	CreatedAt   time.Time `json:"created_at"`   // DB 저장 시간
@@ -27,12 +27,33 @@ type News struct {
 	CommentCount    int `gorm:"default:0" json:"comment_count"`
 	ReadTimeMinutes int `gorm:"default:3" json:"read_time_minutes"`
 
+	// === [신규] 원문 전체 본문 (최초 1회 크롤링 후 재사용: 요약/검색/읽기 시간 계산) ===
+	FullContent         string     `gorm:"type:text" json:"-"`
+	ContentExtractor    string     `gorm:"type:varchar(50)" json:"-"`              // 본문을 추출한 추출기 이름
+	ContentFetchedAt    *time.Time `json:"-"`                                      // 본문 크롤링 시각 (nil 이면 아직 시도 전)
+	ContentURL          string     `gorm:"type:text" json:"-"`                     // 본문을 가져온 URL (조건부 요청은 같은 URL 에만 보냄)
+	ContentETag         string     `gorm:"column:content_etag;type:text" json:"-"` // 재크롤링 시 If-None-Match 로 보낼 값
+	ContentLastModified string     `gorm:"type:text" json:"-"`                     // 재크롤링 시 If-Modified-Since 로 보낼 값
+
+	// === [신규] 메타데이터(이미지/언론사) 보강 상태 ===
+	EnrichmentStatus string `gorm:"type:varchar(20);default:'pending';index" json:"-"`
+
+	// === [신규] 자동 태깅 시각 (nil 이면 아직 태깅 전) ===
+	TaggedAt *time.Time `gorm:"index" json:"-"`
+
 	// 관계 설정 (Interaction, Comment)
 	Interactions []NewsInteraction `gorm:"foreignKey:NewsID" json:"-"`
-	Comments     []NewsComment     `gorm:"foreignKey:NewsID" json:"-"`
+	Comments     []Comment         `gorm:"polymorphic:Target;polymorphicValue:news" json:"-"`
 	Bookmarks    []NewsBookmark    `gorm:"foreignKey:NewsID" json:"-"`
 }
 
+// 메타데이터 보강 상태 값
+const (
+	EnrichmentPending = "pending" // 저장만 되고 아직 보강 전
+	EnrichmentDone    = "done"
+	EnrichmentFailed  = "failed" // 원문 접근 실패 (robots 금지, 4xx 등)
+)
+
 // NewsLike: 뉴스 좋아요 관계 (news_likes)
 type NewsLike struct {
 	UserID    uint `gorm:"primaryKey"`
@@ -51,12 +72,5 @@ type NewsBookmark struct {
 	News      News // belongs to News
 }
 
-// NewsComment: 뉴스 댓글 (news_comments)
-type NewsComment struct {
-	gorm.Model
-	NewsID  uint
-	UserID  uint
-	Content string `gorm:"type:text;not null"`
-	User    User   // belongs to User
-	News    News   // belongs to News
-}
+// [삭제됨] type NewsComment struct { ... }
+// -> Comment(통합 댓글, target_type = 'news')로 이관되었습니다.
@@ -0,0 +1,13 @@
+package models
+
+import "time"
+
+// NewsNeighbor: 아이템 기반 협업 필터링 결과 ("이 기사를 좋아한 사용자가 함께 좋아한 기사")
+// 주기 작업이 뉴스별 상위 K개 이웃만 저장
+type NewsNeighbor struct {
+	NewsID     uint    `gorm:"primaryKey"`
+	NeighborID uint    `gorm:"primaryKey"`
+	Score      float64 `gorm:"not null"`           // 코사인 유사도 (공동 반응 수 / sqrt(각 기사 반응 수 곱))
+	CoCount    int     `gorm:"not null;default:0"` // 두 기사에 모두 반응한 사용자 수
+	UpdatedAt  time.Time
+}
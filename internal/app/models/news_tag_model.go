@@ -0,0 +1,14 @@
+package models
+
+import "time"
+
+// NewsTag: 기사별 자동 태그 (키워드 + 인물/기관/장소 개체명)
+// 수집 후 주기 작업이 제목/본문을 분석해 저장 (pkg/tagger)
+// 태그별 기사 조회는 대소문자를 무시하므로 LOWER(tag) 인덱스를 함께 둠
+type NewsTag struct {
+	NewsID    uint      `gorm:"primaryKey" json:"-"`
+	Tag       string    `gorm:"primaryKey;type:varchar(50);index;index:idx_news_tags_tag_lower,expression:LOWER(tag)" json:"tag"`
+	TagType   string    `gorm:"type:varchar(20);not null" json:"type"` // 'keyword', 'person', 'organization', 'place'
+	Score     float64   `gorm:"not null;default:0" json:"score"`       // 같은 종류 내 상대 중요도 (0~1)
+	CreatedAt time.Time `json:"-"`
+}
@@ -0,0 +1,13 @@
+package models
+
+import "time"
+
+// PostEditHistory: 게시글 수정 이력 (수정 직전의 내용을 보관)
+type PostEditHistory struct {
+	ID        uint   `gorm:"primaryKey"`
+	PostID    uint   `gorm:"not null;index"`
+	Title     string `gorm:"type:varchar(200);not null"`
+	Content   string `gorm:"type:text;not null"`
+	Category  string `gorm:"type:varchar(50)"`
+	CreatedAt time.Time
+}
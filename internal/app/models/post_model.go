@@ -1,6 +1,8 @@
 package models
 
 import (
+	"time"
+
 	"gorm.io/gorm"
 )
 
@@ -22,11 +24,16 @@ type Post struct {
 	DislikeCount int `gorm:"default:0"`
 	CommentCount int `gorm:"default:0"`
 
+	// [신규] 마지막 수정 시각 (수정된 적 없으면 NULL)
+	EditedAt *time.Time
+
 	// 관계 설정
 	User   User        `json:"user"`                            // 작성자 정보 포함
 	Images []PostImage `gorm:"foreignKey:PostID" json:"images"` // [신규]
 	// Likes    []PostLike    `gorm:"foreignKey:PostID" json:"-"`
-	Comments []PostComment `gorm:"foreignKey:PostID" json:"-"`
+	Comments []Comment `gorm:"polymorphic:Target;polymorphicValue:post" json:"-"`
+	// [신규] 수정 이력
+	EditHistories []PostEditHistory `gorm:"foreignKey:PostID" json:"-"`
 }
 
 // PostLike: 게시글 좋아요 (post_likes)
@@ -38,12 +45,5 @@ type Post struct {
 // 	Post      Post // belongs to Post
 // }
 
-// PostComment: 게시글 댓글 (post_comments)
-type PostComment struct {
-	gorm.Model
-	PostID  uint
-	UserID  uint
-	Content string `gorm:"type:text;not null"`
-	User    User   // belongs to User
-	Post    Post   // belongs to Post
-}
+// [삭제됨] type PostComment struct { ... }
+// -> Comment(통합 댓글, target_type = 'post')로 이관되었습니다.
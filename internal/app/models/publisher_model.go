@@ -0,0 +1,23 @@
+package models
+
+import "time"
+
+// 언론사 신뢰도 등급
+const (
+	PublisherTierMajor    = 1 // 통신사/종합일간지/지상파 등 주요 언론
+	PublisherTierStandard = 2 // 경제지/전문지/인터넷 언론 등
+	PublisherTierUnrated  = 3 // 미분류
+)
+
+// Publisher: 언론사 레지스트리 (publishers)
+// 수집 시 원문 도메인/og:site_name 을 여기에 매칭해 News.Source 를 정식 이름으로 통일
+type Publisher struct {
+	ID              uint      `gorm:"primaryKey" json:"id"`
+	Name            string    `gorm:"type:varchar(100);not null;uniqueIndex" json:"name"` // 정식 이름 (예: "연합뉴스")
+	Domains         []string  `gorm:"type:jsonb;serializer:json" json:"domains"`          // 원문 도메인 (서브도메인 포함 매칭, 예: "yna.co.kr")
+	Aliases         []string  `gorm:"type:jsonb;serializer:json" json:"aliases"`          // og:site_name 등 다른 표기 (예: "Yonhap News Agency")
+	LogoURL         string    `gorm:"type:text" json:"logo_url"`
+	ReliabilityTier int       `gorm:"default:3" json:"reliability_tier"` // 1: 주요 언론, 2: 일반, 3: 미분류
+	CreatedAt       time.Time `json:"created_at"`
+	UpdatedAt       time.Time `json:"updated_at"`
+}
@@ -15,6 +15,13 @@ type Short struct {
 	// [수정] 캐시 컬럼: 좋아요, 싫어요 수
 	LikeCount    int `gorm:"default:0" json:"like_count"`
 	DislikeCount int `gorm:"default:0" json:"dislike_count"` // [신규]
+	CommentCount int `gorm:"default:0" json:"comment_count"` // [신규]
+
+	// [신규] 시청 통계 캐시 (클라이언트 시청 이벤트 집계)
+	ViewCount       int   `gorm:"default:0" json:"view_count"`
+	TotalDwellMs    int64 `gorm:"default:0" json:"total_dwell_ms"`
+	CompletionCount int   `gorm:"default:0" json:"completion_count"`
+	SkipCount       int   `gorm:"default:0" json:"skip_count"`
 
 	CreatedAt time.Time `json:"created_at"`
 
@@ -23,20 +30,11 @@ type Short struct {
 
 	// [수정] Likes -> Interactions 변경
 	Interactions []ShortInteraction `gorm:"foreignKey:ShortID" json:"-"`
-	Comments     []ShortComment     `gorm:"foreignKey:ShortID" json:"-"`
+	Comments     []Comment          `gorm:"polymorphic:Target;polymorphicValue:short" json:"-"`
 }
 
 // [삭제됨] type ShortLike struct { ... }
 // -> ShortInteraction이 이 역할을 대신합니다.
 
-// ShortComment: 쇼츠 댓글 (short_comments)
-type ShortComment struct {
-	ID        uint      `gorm:"primaryKey" json:"id"` // gorm.Model 대신 ID, CreatedAt 등 명시하면 JSON 제어하기 편함
-	ShortID   uint      `json:"short_id"`
-	UserID    uint      `json:"user_id"`
-	Content   string    `gorm:"type:text;not null" json:"content"`
-	CreatedAt time.Time `json:"created_at"`
-	UpdatedAt time.Time `json:"updated_at"` // gorm.Model 포함 시 자동 생성됨
-
-	User User `json:"user"` // 댓글 작성자 정보 포함
-}
+// [삭제됨] type ShortComment struct { ... }
+// -> Comment(통합 댓글, target_type = 'short')로 이관되었습니다.
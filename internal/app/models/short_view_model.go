@@ -0,0 +1,14 @@
+package models
+
+import "time"
+
+// ShortView: 사용자별 쇼츠 시청 기록 (맞춤 피드에서 이미 본 쇼츠 제외용)
+type ShortView struct {
+	UserID    uint      `gorm:"primaryKey"`
+	ShortID   uint      `gorm:"primaryKey"`
+	ViewCount int       `gorm:"default:0"`     // 노출(시청) 횟수
+	DwellMs   int64     `gorm:"default:0"`     // 누적 시청 시간 (ms)
+	Completed bool      `gorm:"default:false"` // 끝까지 본 적이 있는지
+	CreatedAt time.Time // 처음 본 시각
+	UpdatedAt time.Time // 마지막으로 본 시각
+}
@@ -0,0 +1,28 @@
+package models
+
+import "time"
+
+// TrendTopic: 급상승 이슈 감지 결과 ("지금 뜨는 이슈")
+// 감지 작업 1회 실행의 결과는 같은 DetectedAt 값을 가지며, API는 가장 최근 실행 결과를 보여줌
+type TrendTopic struct {
+	ID            uint       `gorm:"primaryKey" json:"id"`
+	DetectedAt    time.Time  `gorm:"not null;index" json:"detected_at"`
+	Topic         string     `gorm:"type:varchar(50);not null;index" json:"topic"`   // 대표 태그
+	TopicType     string     `gorm:"type:varchar(20)" json:"topic_type"`             // 'keyword', 'person', 'organization', 'place'
+	RelatedTags   []string   `gorm:"type:jsonb;serializer:json" json:"related_tags"` // 같은 기사 묶음에 함께 등장한 급상승 태그
+	ArticleCount  int        `gorm:"not null;default:0" json:"article_count"`        // 최근 구간 기사 수
+	SourceCount   int        `gorm:"not null;default:0" json:"source_count"`         // 최근 구간 보도 언론사 수
+	BaselineCount int        `gorm:"not null;default:0" json:"baseline_count"`       // 기준 구간 기사 수
+	BurstScore    float64    `gorm:"not null;default:0" json:"burst_score"`          // 평소 대비 증가 배율
+	IsBreaking    bool       `gorm:"default:false;index" json:"is_breaking"`         // 속보성 이슈 (여러 언론사가 급격히 보도)
+	NewsIDs       []uint     `gorm:"type:jsonb;serializer:json" json:"-"`            // 관련 기사 ID (최신순)
+	NotifiedAt    *time.Time `json:"-"`                                              // 속보 알림 발송 시각
+}
+
+// TrendDetectionRun: 급상승 이슈 감지 작업 실행 기록
+// 감지된 이슈가 없는 실행도 기록하여, 이전 실행 결과가 계속 노출되지 않도록 함
+type TrendDetectionRun struct {
+	ID         uint      `gorm:"primaryKey" json:"id"`
+	DetectedAt time.Time `gorm:"not null;uniqueIndex" json:"detected_at"`
+	TopicCount int       `gorm:"not null;default:0" json:"topic_count"` // 감지된 이슈 수
+}
@@ -31,19 +31,19 @@ type User struct {
 	// 3. [수정] 뉴스 상호작용 (Like -> Interaction)
 	NewsInteractions []NewsInteraction `gorm:"foreignKey:UserID" json:"-"`
 	NewsBookmarks    []NewsBookmark    `gorm:"foreignKey:UserID" json:"-"`
-	NewsComments     []NewsComment     `gorm:"foreignKey:UserID" json:"-"`
 
 	// 4. [수정] 쇼츠 상호작용 (Like -> Interaction)
 	ShortInteractions []ShortInteraction `gorm:"foreignKey:UserID" json:"-"`
-	ShortComments     []ShortComment     `gorm:"foreignKey:UserID" json:"-"`
 
 	// 5. 커뮤니티 및 기타 (기존 유지)
 	Posts []Post `gorm:"foreignKey:UserID" json:"-"`
 	// PostLikes     []PostLike     `gorm:"foreignKey:UserID" json:"-"`
-	PostComments  []PostComment  `gorm:"foreignKey:UserID" json:"-"`
 	AlertKeywords []AlertKeyword `gorm:"foreignKey:UserID" json:"-"`
 	Notifications []Notification `gorm:"foreignKey:UserID" json:"-"`
 	Reported      []Report       `gorm:"foreignKey:ReporterID" json:"-"`
+
+	// 6. [신규] 통합 댓글 (뉴스/쇼츠/게시글)
+	Comments []Comment `gorm:"foreignKey:UserID" json:"-"`
 }
 
 // UserSetting: 사용자 설정 (user_settings)
@@ -0,0 +1,16 @@
+package models
+
+import "time"
+
+// UserMute: 사용자 "관심 없음" 피드백 (뉴스 목록/쇼츠/추천에서 제외)
+//   - mute_type: 'news'(기사 숨김), 'source'(언론사), 'category'(카테고리), 'keyword'(제목 키워드)
+//   - value: 기사 숨김이면 뉴스 ID 문자열, 그 외에는 언론사명/카테고리명/키워드
+//   - publisher_id: 언론사 뮤트가 레지스트리 언론사와 매칭되면 연결 (표기가 달라도 같은 언론사로 판단)
+type UserMute struct {
+	ID          uint      `gorm:"primaryKey" json:"id"`
+	UserID      uint      `gorm:"not null;uniqueIndex:idx_user_mutes_unique" json:"-"`
+	MuteType    string    `gorm:"type:varchar(20);not null;uniqueIndex:idx_user_mutes_unique" json:"type"`
+	Value       string    `gorm:"type:varchar(100);not null;uniqueIndex:idx_user_mutes_unique" json:"value"`
+	PublisherID *uint     `gorm:"index" json:"publisher_id,omitempty"`
+	CreatedAt   time.Time `json:"created_at"`
+}
@@ -1,25 +1,81 @@
 package repositories
 
 import (
+	"errors"
 	"newsclip/backend/config"
 	"newsclip/backend/internal/app/models"
+	"time"
 
 	"gorm.io/gorm"
 )
 
-// === [뉴스] 댓글 ===
+// === 댓글 대상 타입 → comment_count를 가진 대상 테이블 ===
+// (댓글을 달 수 있는 대상을 추가하려면 여기에 한 줄만 추가하면 됨)
+var commentTargetTables = map[string]string{
+	"news":  "news",
+	"short": "shorts",
+	"post":  "posts",
+}
+
+func getCommentTargetTable(targetType string) (string, error) {
+	table, ok := commentTargetTables[targetType]
+	if !ok {
+		return "", errors.New("잘못된 대상 타입입니다")
+	}
+	return table, nil
+}
+
+// === 댓글 정렬 기준 ===
+const (
+	CommentSortLatest = "latest" // 최신순 (기본값)
+	CommentSortOldest = "oldest" // 작성순
+	CommentSortBest   = "best"   // 베스트순 (Wilson score)
+)
+
+// (헬퍼) 좋아요/싫어요 수로 계산한 Wilson score 하한값 (95% 신뢰구간) SQL 식
+// 평가 수가 적은 댓글이 우연히 상위에 오르는 것을 막기 위해 단순 비율 대신 사용
+// (평가가 없으면 0)
+func wilsonScoreSQL() string {
+	l := "like_count::float8"
+	d := "dislike_count::float8"
+	n := "(" + l + " + " + d + ")"
+	return "(CASE WHEN " + n + " = 0 THEN 0 ELSE " +
+		"((" + l + " + 1.9208) / " + n + " - 1.96 * SQRT((" + l + " * " + d + ") / " + n + " + 0.9604) / " + n + ") / (1 + 3.8416 / " + n + ")" +
+		" END)"
+}
+
+// (헬퍼) 답글 생성/삭제 시 부모 댓글의 reply_count 증감
+func incrementReplyCount(tx *gorm.DB, parentID *uint, delta int) error {
+	if parentID == nil {
+		return nil
+	}
+	return tx.Model(&models.Comment{}).
+		Where("id = ?", *parentID).
+		UpdateColumn("reply_count", gorm.Expr("reply_count + ?", delta)).Error
+}
+
+// === 댓글 생성 ===
+// 댓글 생성 시 대상 테이블의 comment_count도 +1
+func CreateComment(comment *models.Comment) error {
+	targetTable, err := getCommentTargetTable(comment.TargetType)
+	if err != nil {
+		return err
+	}
 
-// 댓글 생성 시 news 테이블의 comment_count도 +1
-func CreateNewsComment(comment *models.NewsComment) error {
 	return config.DB.Transaction(func(tx *gorm.DB) error {
 		// 1. 댓글 생성
 		if err := tx.Create(comment).Error; err != nil {
 			return err
 		}
 
-		// 2. 뉴스 테이블의 comment_count +1 증가
-		if err := tx.Model(&models.News{}).
-			Where("id = ?", comment.NewsID).
+		// 1-1. 답글이면 부모 댓글의 reply_count +1
+		if err := incrementReplyCount(tx, comment.ParentID, 1); err != nil {
+			return err
+		}
+
+		// 2. 대상 테이블의 comment_count +1 증가
+		if err := tx.Table(targetTable).
+			Where("id = ?", comment.TargetID).
 			UpdateColumn("comment_count", gorm.Expr("comment_count + ?", 1)).Error; err != nil {
 			return err
 		}
@@ -28,96 +84,289 @@ func CreateNewsComment(comment *models.NewsComment) error {
 	})
 }
 
-func GetNewsComments(newsID uint) ([]models.NewsComment, error) {
-	var comments []models.NewsComment
-	result := config.DB.Preload("User").
-		Where("news_id = ?", newsID).
-		Order("created_at DESC").
-		Find(&comments)
-	return comments, result.Error
+// === 댓글 단건 조회 ===
+func FindCommentByID(commentID uint) (models.Comment, error) {
+	var comment models.Comment
+	result := config.DB.First(&comment, commentID)
+	return comment, result.Error
 }
 
-// === [쇼츠] 댓글 ===
+// === 최상위 댓글 목록 조회 (커서 페이징) ===
+//   - sortBy: latest(최신순) / oldest(작성순) / best(Wilson score 내림차순, 동점이면 최신순)
+//   - cursorID가 0이면 처음부터, 0보다 크면 해당 댓글 다음 순서부터
+//   - 삭제된 댓글은 답글이 남아있는 경우에만 포함 (자리표시자용)
+func GetTopLevelComments(targetType string, targetID uint, sortBy string, cursorID uint, limit int) ([]models.Comment, error) {
+	var comments []models.Comment
 
-// 댓글 생성 시 shorts 테이블의 comment_count도 +1
-func CreateShortComment(comment *models.ShortComment) error {
-	return config.DB.Transaction(func(tx *gorm.DB) error {
-		// 1. 댓글 생성
-		if err := tx.Create(comment).Error; err != nil {
-			return err
+	query := config.DB.Preload("User").
+		Where("target_type = ? AND target_id = ?", targetType, targetID).
+		Where("parent_id IS NULL").
+		Where("(is_deleted = false OR reply_count > 0)")
+
+	switch sortBy {
+	case CommentSortOldest:
+		if cursorID > 0 {
+			query = query.Where("id > ?", cursorID)
 		}
+		query = query.Order("id ASC")
 
-		// 2. 쇼츠 테이블의 comment_count +1 증가
-		if err := tx.Model(&models.Short{}).
-			Where("id = ?", comment.ShortID).
-			UpdateColumn("comment_count", gorm.Expr("comment_count + ?", 1)).Error; err != nil {
-			return err
+	case CommentSortBest:
+		score := wilsonScoreSQL()
+		if cursorID > 0 {
+			// 커서 댓글의 현재 점수를 기준으로 (점수, ID) 순서상 다음 댓글부터
+			cursorScore := config.DB.Model(&models.Comment{}).Select(score).Where("id = ?", cursorID)
+			query = query.Where("("+score+" < (?) OR ("+score+" = (?) AND id < ?))", cursorScore, cursorScore, cursorID)
 		}
+		query = query.Order(score + " DESC").Order("id DESC")
 
-		return nil
-	})
+	default: // CommentSortLatest
+		if cursorID > 0 {
+			query = query.Where("id < ?", cursorID)
+		}
+		query = query.Order("id DESC")
+	}
+
+	err := query.Limit(limit).Find(&comments).Error
+	return comments, err
 }
 
-func GetShortComments(shortID uint) ([]models.ShortComment, error) {
-	var comments []models.ShortComment
-	result := config.DB.Preload("User").
-		Where("short_id = ?", shortID).
-		Order("created_at DESC").
-		Find(&comments)
-	return comments, result.Error
+// === 답글 목록 조회 (커서 페이징, 작성순) ===
+//   - cursorID가 0이면 처음부터, 0보다 크면 그 ID보다 큰 것부터
+func GetCommentReplies(parentID uint, cursorID uint, limit int) ([]models.Comment, error) {
+	var replies []models.Comment
+
+	query := config.DB.Preload("User").
+		Where("parent_id = ?", parentID).
+		Where("is_deleted = false")
+
+	if cursorID > 0 {
+		query = query.Where("id > ?", cursorID)
+	}
+
+	err := query.Order("id ASC").Limit(limit).Find(&replies).Error
+	return replies, err
 }
 
-// === [커뮤니티] 댓글 ===
+// === 댓글 내용 수정 ===
+func UpdateCommentContent(comment *models.Comment, content string) error {
+	return config.DB.Model(comment).Updates(map[string]interface{}{
+		"content":   content,
+		"edited_at": time.Now(),
+	}).Error
+}
+
+// === 댓글 삭제 (Soft Delete) ===
+// 삭제 표시 후 대상의 comment_count -1, 답글이면 부모의 reply_count -1
+// (이미 삭제된 댓글이면 카운트를 건드리지 않음)
+func SoftDeleteComment(comment *models.Comment) error {
+	targetTable, err := getCommentTargetTable(comment.TargetType)
+	if err != nil {
+		return err
+	}
 
-// 댓글 생성 시 posts 테이블의 comment_count도 +1
-func CreatePostComment(comment *models.PostComment) error {
 	return config.DB.Transaction(func(tx *gorm.DB) error {
-		// 1. 댓글 생성
-		if err := tx.Create(comment).Error; err != nil {
-			return err
+		// 1. 삭제 표시 (is_deleted = false인 경우에만)
+		result := tx.Model(&models.Comment{}).
+			Where("id = ? AND is_deleted = false", comment.ID).
+			Update("is_deleted", true)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return nil // 이미 삭제됨
 		}
 
-		// 2. 게시글 테이블의 comment_count +1 증가
-		if err := tx.Model(&models.Post{}).
-			Where("id = ?", comment.PostID).
-			UpdateColumn("comment_count", gorm.Expr("comment_count + ?", 1)).Error; err != nil {
+		// 2. 대상 테이블의 comment_count -1
+		if err := tx.Table(targetTable).
+			Where("id = ?", comment.TargetID).
+			UpdateColumn("comment_count", gorm.Expr("GREATEST(comment_count - 1, 0)")).Error; err != nil {
 			return err
 		}
 
-		return nil
+		// 3. 답글이면 부모 댓글의 reply_count -1
+		return incrementReplyCount(tx, comment.ParentID, -1)
 	})
 }
 
-func GetPostComments(postID uint) ([]models.PostComment, error) {
-	var comments []models.PostComment
-	result := config.DB.Preload("User").
-		Where("post_id = ?", postID).
-		Order("created_at DESC").
-		Find(&comments)
-	return comments, result.Error
+// === 댓글 상호작용 처리를 위한 함수 ===
+
+// 1. 기존 상호작용 조회
+func FindCommentInteraction(tx *gorm.DB, userID uint, targetType string, commentID uint) (models.CommentInteraction, error) {
+	var interaction models.CommentInteraction
+	result := tx.Where("user_id = ? AND target_type = ? AND comment_id = ?", userID, targetType, commentID).First(&interaction)
+	return interaction, result.Error
+}
+
+// 2. 상호작용 생성
+func CreateCommentInteraction(tx *gorm.DB, interaction *models.CommentInteraction) error {
+	return tx.Create(interaction).Error
+}
+
+// 3. 상호작용 삭제 (취소)
+func DeleteCommentInteraction(tx *gorm.DB, interaction *models.CommentInteraction) error {
+	return tx.Where("user_id = ? AND target_type = ? AND comment_id = ?",
+		interaction.UserID, interaction.TargetType, interaction.CommentID).
+		Delete(&models.CommentInteraction{}).Error
+}
+
+// 4. 상호작용 타입 변경 (like <-> dislike)
+func UpdateCommentInteraction(tx *gorm.DB, interaction *models.CommentInteraction, newType string) error {
+	return tx.Model(&models.CommentInteraction{}).
+		Where("user_id = ? AND target_type = ? AND comment_id = ?",
+			interaction.UserID, interaction.TargetType, interaction.CommentID).
+		Update("interaction_type", newType).Error
+}
+
+// 5. 댓글 카운트 업데이트 (LikeCount, DislikeCount 증감)
+func UpdateCommentCounts(tx *gorm.DB, commentID uint, likeDelta int, dislikeDelta int) error {
+	return tx.Model(&models.Comment{}).Where("id = ?", commentID).Updates(map[string]interface{}{
+		"like_count":    gorm.Expr("like_count + ?", likeDelta),
+		"dislike_count": gorm.Expr("dislike_count + ?", dislikeDelta),
+	}).Error
+}
+
+// (최적화) 특정 유저가 댓글 ID 목록에 대해 어떤 상호작용을 했는지 일괄 조회
+func FindCommentInteractionsByIDs(userID uint, targetType string, commentIDs []uint) ([]models.CommentInteraction, error) {
+	var interactions []models.CommentInteraction
+	result := config.DB.Where("user_id = ? AND target_type = ? AND comment_id IN ?", userID, targetType, commentIDs).Find(&interactions)
+	return interactions, result.Error
 }
 
 // === [7.8] 내가 쓴 댓글 목록 조회 ===
-func GetMyComments(userID uint, page, size int) ([]map[string]interface{}, error) {
-	var results []map[string]interface{}
+func GetMyComments(userID uint, page, size int) ([]models.Comment, error) {
+	var comments []models.Comment
 	offset := (page - 1) * size
 
-	sql := `
-	SELECT id, content, created_at, 'news' AS target_type, news_id AS target_id
-	FROM news_comments
-	WHERE user_id = ?
-	UNION ALL
-	SELECT id, content, created_at, 'short' AS target_type, short_id AS target_id
-	FROM short_comments
-	WHERE user_id = ?
-	UNION ALL
-	SELECT id, content, created_at, 'post' AS target_type, post_id AS target_id
-	FROM post_comments
-	WHERE user_id = ?
-	ORDER BY created_at DESC
-	LIMIT ? OFFSET ?
-	`
-
-	err := config.DB.Raw(sql, userID, userID, userID, size, offset).Scan(&results).Error
-	return results, err
+	err := config.DB.
+		Where("user_id = ? AND is_deleted = false", userID).
+		Order("created_at DESC").
+		Limit(size).
+		Offset(offset).
+		Find(&comments).Error
+
+	return comments, err
+}
+
+// ====================================================================
+//  기존 댓글 테이블(news_comments / short_comments / post_comments) →
+//  통합 댓글 테이블(comments) 데이터 이관
+// ====================================================================
+
+// 기존 댓글 테이블 정보
+type legacyCommentTable struct {
+	TargetType   string
+	Table        string
+	TargetColumn string
+}
+
+var legacyCommentTables = []legacyCommentTable{
+	{TargetType: "news", Table: "news_comments", TargetColumn: "news_id"},
+	{TargetType: "short", Table: "short_comments", TargetColumn: "short_id"},
+	{TargetType: "post", Table: "post_comments", TargetColumn: "post_id"},
+}
+
+// 기존 댓글을 comments 테이블로 이관하고 이관된 댓글 수를 반환
+//   - comments 테이블이 비어 있을 때만 실행 (서버 재시작 시 중복 이관 방지)
+//   - 답글의 parent_id와 댓글 좋아요(comment_interactions)의 comment_id를 새 ID로 변환
+//   - 기존 테이블은 삭제하지 않음 (확인 후 수동으로 DROP)
+func MigrateLegacyComments() (int64, error) {
+	migrator := config.DB.Migrator()
+
+	var existing int64
+	if err := config.DB.Model(&models.Comment{}).Count(&existing).Error; err != nil {
+		return 0, err
+	}
+	if existing > 0 {
+		return 0, nil
+	}
+
+	var migrated int64
+
+	err := config.DB.Transaction(func(tx *gorm.DB) error {
+		// 1. 기존 ID ↔ 새 ID 매핑용 임시 컬럼
+		if err := tx.Exec("ALTER TABLE comments ADD COLUMN IF NOT EXISTS legacy_id bigint").Error; err != nil {
+			return err
+		}
+
+		for _, lt := range legacyCommentTables {
+			if !migrator.HasTable(lt.Table) {
+				continue
+			}
+
+			// 이전 버전 테이블에는 없을 수 있는 컬럼은 기본값으로 대체
+			column := func(name, fallback string) string {
+				if migrator.HasColumn(lt.Table, name) {
+					return name
+				}
+				return fallback
+			}
+
+			where := ""
+			if migrator.HasColumn(lt.Table, "deleted_at") {
+				where = "WHERE deleted_at IS NULL"
+			}
+
+			// 2. 댓글 복사
+			insertSQL := `
+			INSERT INTO comments (target_type, target_id, user_id, content, reply_count, is_deleted, edited_at,
+				like_count, dislike_count, created_at, updated_at, legacy_id)
+			SELECT ?, ` + lt.TargetColumn + `, user_id, content, ` +
+				column("reply_count", "0") + `, ` +
+				column("is_deleted", "false") + `, ` +
+				column("edited_at", "NULL") + `, ` +
+				column("like_count", "0") + `, ` +
+				column("dislike_count", "0") + `, created_at, COALESCE(updated_at, created_at), id
+			FROM ` + lt.Table + ` ` + where + `
+			ORDER BY id`
+
+			result := tx.Exec(insertSQL, lt.TargetType)
+			if result.Error != nil {
+				return result.Error
+			}
+			migrated += result.RowsAffected
+
+			// 3. 답글의 parent_id를 새 ID로 변환
+			if migrator.HasColumn(lt.Table, "parent_id") {
+				if err := tx.Exec(`
+				UPDATE comments AS c
+				SET parent_id = p.id
+				FROM `+lt.Table+` AS lc
+				JOIN comments AS p ON p.target_type = ? AND p.legacy_id = lc.parent_id
+				WHERE c.target_type = ? AND c.legacy_id = lc.id AND lc.parent_id IS NOT NULL`,
+					lt.TargetType, lt.TargetType).Error; err != nil {
+					return err
+				}
+			}
+		}
+
+		// 4. 댓글 좋아요/싫어요의 comment_id를 새 ID로 변환
+		// (기본키에 comment_id가 포함되어 있어 UPDATE 대신 다시 적재)
+		if migrator.HasTable(&models.CommentInteraction{}) {
+			if err := tx.Exec(`
+			CREATE TEMP TABLE comment_interactions_remap ON COMMIT DROP AS
+			SELECT ci.user_id, ci.target_type, c.id AS comment_id, ci.interaction_type, ci.created_at
+			FROM comment_interactions AS ci
+			JOIN comments AS c ON c.target_type = ci.target_type AND c.legacy_id = ci.comment_id`).Error; err != nil {
+				return err
+			}
+			if err := tx.Exec("DELETE FROM comment_interactions").Error; err != nil {
+				return err
+			}
+			if err := tx.Exec(`
+			INSERT INTO comment_interactions (user_id, target_type, comment_id, interaction_type, created_at)
+			SELECT user_id, target_type, comment_id, interaction_type, created_at
+			FROM comment_interactions_remap`).Error; err != nil {
+				return err
+			}
+		}
+
+		// 5. 임시 컬럼 제거
+		return tx.Exec("ALTER TABLE comments DROP COLUMN legacy_id").Error
+	})
+
+	if err != nil {
+		return 0, err
+	}
+
+	return migrated, nil
 }
@@ -0,0 +1,58 @@
+package repositories
+
+import (
+	"newsclip/backend/config"
+	"newsclip/backend/internal/app/models"
+	"time"
+)
+
+// ====================================================================
+//  오프라인 추천 평가용 조회 함수
+// ====================================================================
+
+// 사용자-뉴스 반응 기록 (좋아요/싫어요/북마크)
+type UserNewsSignal struct {
+	UserID     uint
+	NewsID     uint
+	SignalType string // 'like', 'dislike', 'bookmark'
+	CreatedAt  time.Time
+}
+
+// [신규] 기간 내 사용자 반응 기록 조회 (시간순)
+func FindUserNewsSignalsBetween(from, to time.Time) ([]UserNewsSignal, error) {
+	var signals []UserNewsSignal
+
+	err := config.DB.Raw(`
+		SELECT user_id, news_id, interaction_type AS signal_type, created_at
+		FROM news_interactions
+		WHERE created_at >= ? AND created_at < ?
+		UNION ALL
+		SELECT user_id, news_id, 'bookmark' AS signal_type, created_at
+		FROM news_bookmarks
+		WHERE created_at >= ? AND created_at < ?
+		ORDER BY created_at ASC`,
+		from, to, from, to).
+		Scan(&signals).Error
+
+	return signals, err
+}
+
+// [신규] 발행 시각이 기간 내인 뉴스 조회
+func FindNewsPublishedBetween(from, to time.Time) ([]models.News, error) {
+	var newsList []models.News
+	err := config.DB.
+		Where("published_at >= ? AND published_at < ?", from, to).
+		Order("published_at DESC").
+		Find(&newsList).Error
+	return newsList, err
+}
+
+// [신규] ID 목록으로 뉴스 조회 (순서는 보장하지 않음)
+func FindNewsByIDs(ids []uint) ([]models.News, error) {
+	var newsList []models.News
+	if len(ids) == 0 {
+		return newsList, nil
+	}
+	err := config.DB.Where("id IN ?", ids).Find(&newsList).Error
+	return newsList, err
+}
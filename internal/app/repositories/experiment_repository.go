@@ -0,0 +1,88 @@
+package repositories
+
+import (
+	"newsclip/backend/config"
+	"newsclip/backend/internal/app/models"
+	"time"
+
+	"gorm.io/gorm/clause"
+)
+
+// ====================================================================
+//  A/B 실험 노출 기록 (experiment_exposures)
+// ====================================================================
+
+// [신규] 노출 기록 일괄 저장 (이미 노출된 사용자-아이템 쌍은 무시)
+func CreateExperimentExposures(exposures []models.ExperimentExposure) error {
+	if len(exposures) == 0 {
+		return nil
+	}
+	return config.DB.Clauses(clause.OnConflict{DoNothing: true}).Create(&exposures).Error
+}
+
+// [신규] 노출된 아이템을 사용자가 클릭(상세 조회/시청)했음을 기록 (최초 1회만)
+func MarkExperimentExposuresClicked(userID uint, itemType string, itemIDs []uint) error {
+	if len(itemIDs) == 0 {
+		return nil
+	}
+	return config.DB.Model(&models.ExperimentExposure{}).
+		Where("user_id = ? AND item_type = ? AND item_id IN ? AND clicked_at IS NULL", userID, itemType, itemIDs).
+		Update("clicked_at", time.Now()).Error
+}
+
+// 실험 변형별 성과 집계
+type ExperimentVariantStats struct {
+	Variant   string
+	Users     int64
+	Exposures int64
+	Clicks    int64
+	Likes     int64
+	Dislikes  int64
+	Bookmarks int64
+}
+
+// [신규] 실험 변형별 노출/클릭/좋아요/싫어요/북마크 집계
+// (좋아요 등은 노출 이후에 발생한 상호작용만 계산)
+func GetExperimentVariantStats(experimentKey string, since time.Time) ([]ExperimentVariantStats, error) {
+	var rows []ExperimentVariantStats
+
+	err := config.DB.Raw(`
+		SELECT e.variant AS variant,
+			COUNT(DISTINCT e.user_id) AS users,
+			COUNT(*) AS exposures,
+			COUNT(e.clicked_at) AS clicks,
+			COUNT(*) FILTER (WHERE
+				(e.item_type = 'news' AND EXISTS (
+					SELECT 1 FROM news_interactions ni
+					WHERE ni.user_id = e.user_id AND ni.news_id = e.item_id
+						AND ni.interaction_type = 'like' AND ni.created_at >= e.created_at))
+				OR (e.item_type = 'short' AND EXISTS (
+					SELECT 1 FROM short_interactions si
+					WHERE si.user_id = e.user_id AND si.short_id = e.item_id
+						AND si.interaction_type = 'like' AND si.created_at >= e.created_at))
+			) AS likes,
+			COUNT(*) FILTER (WHERE
+				(e.item_type = 'news' AND EXISTS (
+					SELECT 1 FROM news_interactions ni
+					WHERE ni.user_id = e.user_id AND ni.news_id = e.item_id
+						AND ni.interaction_type = 'dislike' AND ni.created_at >= e.created_at))
+				OR (e.item_type = 'short' AND EXISTS (
+					SELECT 1 FROM short_interactions si
+					WHERE si.user_id = e.user_id AND si.short_id = e.item_id
+						AND si.interaction_type = 'dislike' AND si.created_at >= e.created_at))
+			) AS dislikes,
+			COUNT(*) FILTER (WHERE
+				e.item_type = 'news' AND EXISTS (
+					SELECT 1 FROM news_bookmarks nb
+					WHERE nb.user_id = e.user_id AND nb.news_id = e.item_id
+						AND nb.created_at >= e.created_at))
+			AS bookmarks
+		FROM experiment_exposures e
+		WHERE e.experiment_key = ? AND e.created_at >= ?
+		GROUP BY e.variant
+		ORDER BY e.variant`,
+		experimentKey, since).
+		Scan(&rows).Error
+
+	return rows, err
+}
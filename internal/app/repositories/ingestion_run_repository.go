@@ -0,0 +1,82 @@
+package repositories
+
+import (
+	"math"
+	"newsclip/backend/config"
+	"newsclip/backend/internal/app/models"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// === 수집 실행 기록 생성 ===
+func CreateIngestionRun(run *models.IngestionRun) error {
+	return config.DB.Omit("Categories").Create(run).Error
+}
+
+// === 수집 실행 기록 저장 (종료 시 집계값/상태 갱신) ===
+// (EnrichmentFailed 는 보강 워커가 따로 증가시키므로 덮어쓰지 않음)
+func UpdateIngestionRun(run *models.IngestionRun) error {
+	return config.DB.Model(run).
+		Select("Status", "FinishedAt", "Fetched", "Duplicates", "Stored", "ErrorCount").
+		Updates(run).Error
+}
+
+// === 카테고리별 결과 생성/저장 ===
+func CreateIngestionCategoryRun(categoryRun *models.IngestionCategoryRun) error {
+	return config.DB.Create(categoryRun).Error
+}
+
+func UpdateIngestionCategoryRun(categoryRun *models.IngestionCategoryRun) error {
+	return config.DB.Model(categoryRun).
+		Select("FinishedAt", "Fetched", "Duplicates", "Stored", "Error").
+		Updates(categoryRun).Error
+}
+
+// === 메타데이터 보강 실패 1건 기록 (카테고리 결과 + 상위 실행 기록) ===
+func IncrementIngestionEnrichmentFailures(categoryRunID uint) error {
+	return config.DB.Transaction(func(tx *gorm.DB) error {
+		var categoryRun models.IngestionCategoryRun
+		if err := tx.Select("id", "run_id").First(&categoryRun, categoryRunID).Error; err != nil {
+			return err
+		}
+		if err := tx.Model(&categoryRun).
+			UpdateColumn("enrichment_failed", gorm.Expr("enrichment_failed + 1")).Error; err != nil {
+			return err
+		}
+		return tx.Model(&models.IngestionRun{}).Where("id = ?", categoryRun.RunID).
+			UpdateColumn("enrichment_failed", gorm.Expr("enrichment_failed + 1")).Error
+	})
+}
+
+// === 서버 재시작 등으로 'running' 상태로 남은 실행 기록을 실패 처리 ===
+func MarkInterruptedIngestionRuns(now time.Time) (int64, error) {
+	result := config.DB.Model(&models.IngestionRun{}).
+		Where("status = ?", models.IngestionRunning).
+		Updates(map[string]interface{}{"status": models.IngestionFailed, "finished_at": now})
+	return result.RowsAffected, result.Error
+}
+
+// === 수집 실행 기록 목록 (최신순, 카테고리별 결과 포함) ===
+func FindIngestionRuns(page int, size int) ([]models.IngestionRun, int64, int, error) {
+	var runs []models.IngestionRun
+	var totalCount int64
+
+	if err := config.DB.Model(&models.IngestionRun{}).Count(&totalCount).Error; err != nil {
+		return nil, 0, 0, err
+	}
+
+	offset := (page - 1) * size
+	err := config.DB.
+		Preload("Categories", func(db *gorm.DB) *gorm.DB { return db.Order("category ASC") }).
+		Order("started_at DESC").
+		Offset(offset).
+		Limit(size).
+		Find(&runs).Error
+	if err != nil {
+		return nil, 0, 0, err
+	}
+
+	totalPages := int(math.Ceil(float64(totalCount) / float64(size)))
+	return runs, totalCount, totalPages, nil
+}
@@ -0,0 +1,152 @@
+package repositories
+
+import (
+	"newsclip/backend/config"
+	"newsclip/backend/internal/app/models"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// ====================================================================
+//  아이템 기반 협업 필터링 (news_neighbors)
+// ====================================================================
+
+// 사용자 긍정 반응(좋아요 + 북마크) 서브쿼리 SQL
+// (같은 기사에 좋아요와 북마크를 모두 한 경우 1건으로 취급)
+const newsPositiveSignalsSQL = `
+	SELECT user_id, news_id FROM news_interactions
+	WHERE interaction_type = 'like' AND created_at > @cutoff
+	UNION
+	SELECT user_id, news_id FROM news_bookmarks
+	WHERE created_at > @cutoff`
+
+// [신규] 기사 간 공동 반응 기반 유사도를 계산해 뉴스별 상위 topK 이웃으로 교체 저장
+//   - 최근 daysWithin 일 이내의 좋아요/북마크만 사용
+//   - 공동 반응 사용자 수가 minCoCount 미만인 쌍은 제외
+//
+// 반환값: 저장된 이웃 수
+func RebuildNewsNeighbors(daysWithin int, topK int, minCoCount int) (int64, error) {
+	cutoff := time.Now().AddDate(0, 0, -daysWithin)
+
+	var saved int64
+	err := config.DB.Transaction(func(tx *gorm.DB) error {
+		// 1. 기존 결과 전체 삭제 (매 실행마다 새로 계산)
+		if err := tx.Exec("DELETE FROM news_neighbors").Error; err != nil {
+			return err
+		}
+
+		// 2. 공동 반응 집계 → 코사인 유사도 → 뉴스별 상위 K개만 삽입
+		result := tx.Exec(`
+			WITH signals AS (`+newsPositiveSignalsSQL+`
+			),
+			item_counts AS (
+				SELECT news_id, COUNT(*) AS cnt FROM signals GROUP BY news_id
+			),
+			pairs AS (
+				SELECT a.news_id AS news_id, b.news_id AS neighbor_id, COUNT(*) AS co_count
+				FROM signals a
+				JOIN signals b ON a.user_id = b.user_id AND a.news_id <> b.news_id
+				GROUP BY a.news_id, b.news_id
+				HAVING COUNT(*) >= @minCoCount
+			),
+			ranked AS (
+				SELECT p.news_id, p.neighbor_id, p.co_count,
+					p.co_count / SQRT(ca.cnt::float8 * cb.cnt::float8) AS score,
+					ROW_NUMBER() OVER (
+						PARTITION BY p.news_id
+						ORDER BY p.co_count / SQRT(ca.cnt::float8 * cb.cnt::float8) DESC, p.neighbor_id DESC
+					) AS rn
+				FROM pairs p
+				JOIN item_counts ca ON ca.news_id = p.news_id
+				JOIN item_counts cb ON cb.news_id = p.neighbor_id
+			)
+			INSERT INTO news_neighbors (news_id, neighbor_id, score, co_count, updated_at)
+			SELECT r.news_id, r.neighbor_id, r.score, r.co_count, NOW()
+			FROM ranked r
+			JOIN news n1 ON n1.id = r.news_id
+			JOIN news n2 ON n2.id = r.neighbor_id
+			WHERE r.rn <= @topK`,
+			map[string]interface{}{
+				"cutoff":     cutoff,
+				"minCoCount": minCoCount,
+				"topK":       topK,
+			})
+		if result.Error != nil {
+			return result.Error
+		}
+		saved = result.RowsAffected
+		return nil
+	})
+
+	return saved, err
+}
+
+// [신규] 특정 뉴스의 연관 뉴스 조회 (유사도 내림차순)
+func FindRelatedNews(newsID uint, limit int) ([]models.News, error) {
+	var newsList []models.News
+
+	err := config.DB.Table("news").
+		Joins("JOIN news_neighbors AS nn ON nn.neighbor_id = news.id").
+		Where("nn.news_id = ?", newsID).
+		Order("nn.score DESC, news.published_at DESC").
+		Limit(limit).
+		Find(&newsList).Error
+
+	return newsList, err
+}
+
+// [신규] 같은 카테고리의 최신 뉴스 조회 (연관 뉴스가 부족할 때 채우기용)
+func FindRecentNewsInCategory(category string, excludeIDs []uint, limit int) ([]models.News, error) {
+	var newsList []models.News
+
+	query := config.DB.Where("category = ?", category)
+	if len(excludeIDs) > 0 {
+		query = query.Where("id NOT IN ?", excludeIDs)
+	}
+
+	err := query.Order("published_at DESC").
+		Limit(limit).
+		Find(&newsList).Error
+
+	return newsList, err
+}
+
+// [신규] 사용자가 좋아요/북마크한 기사들의 이웃 점수를 후보 뉴스별로 합산
+//
+//	key: 후보 뉴스 ID, value: 이웃 유사도 합
+func GetNeighborScoresForUser(userID uint, candidateIDs []uint) (map[uint]float64, error) {
+	scores := make(map[uint]float64)
+	if len(candidateIDs) == 0 {
+		return scores, nil
+	}
+
+	type resultRow struct {
+		NeighborID uint
+		Score      float64
+	}
+
+	subLikes := config.DB.Table("news_interactions").
+		Select("news_id").
+		Where("user_id = ? AND interaction_type = ?", userID, "like")
+
+	subBookmarks := config.DB.Table("news_bookmarks").
+		Select("news_id").
+		Where("user_id = ?", userID)
+
+	var rows []resultRow
+	err := config.DB.Table("news_neighbors").
+		Select("neighbor_id, SUM(score) AS score").
+		Where("(news_id IN (?) OR news_id IN (?))", subLikes, subBookmarks).
+		Where("neighbor_id IN ?", candidateIDs).
+		Group("neighbor_id").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	for _, r := range rows {
+		scores[r.NeighborID] = r.Score
+	}
+	return scores, nil
+}
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 // ExternalID(Naver News 링크)로 뉴스를 찾습니다.
@@ -17,17 +18,110 @@ func FindNewsByExternalID(externalID string) (models.News, error) {
 	return news, result.Error
 }
 
+// [신규] 여러 ExternalID 중 이미 저장된 것을 한 번에 조회합니다.
+// (뉴스 수집 시 중복 체크용, 기사마다 쿼리하지 않도록)
+func FindExistingExternalIDs(externalIDs []string) (map[string]bool, error) {
+	existing := make(map[string]bool, len(externalIDs))
+	if len(externalIDs) == 0 {
+		return existing, nil
+	}
+
+	var found []string
+	err := config.DB.Model(&models.News{}).
+		Where("external_id IN ?", externalIDs).
+		Pluck("external_id", &found).Error
+	if err != nil {
+		return nil, err
+	}
+	for _, id := range found {
+		existing[id] = true
+	}
+	return existing, nil
+}
+
+// [신규] 카테고리에서 가장 최근에 발행된 기사 시각 (증분 수집 기준점, 없으면 zero time)
+func FindLatestPublishedAt(category string) (time.Time, error) {
+	var latest *time.Time
+	err := config.DB.Model(&models.News{}).
+		Where("category = ?", category).
+		Select("MAX(published_at)").
+		Scan(&latest).Error
+	if err != nil || latest == nil {
+		return time.Time{}, err
+	}
+	return *latest, nil
+}
+
 // 수집된 뉴스 목록을 DB에 일괄 생성(Batch Create)합니다.
-func CreateNewsBatch(newsList []models.News) error {
+// [수정] 다른 카테고리 수집과 동시에 같은 기사를 저장하는 경우 충돌 무시 (실제로 저장된 개수 반환)
+// (충돌 시 newsList 의 ID 가 정확하지 않을 수 있으므로, 저장 후 ID 가 필요하면 다시 조회할 것)
+func CreateNewsBatch(newsList []models.News) (int64, error) {
 	// GORM의 CreateInBatches를 사용하면 효율적입니다.
 	// (단, GORM 2.0 이상 필요)
-	result := config.DB.CreateInBatches(newsList, 100) // 100개씩 나눠서 삽입
-	return result.Error
+	result := config.DB.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "external_id"}},
+		DoNothing: true,
+	}).CreateInBatches(&newsList, 100) // 100개씩 나눠서 삽입
+	return result.RowsAffected, result.Error
+}
+
+// === [신규] 메타데이터 보강 대기 중인 뉴스 조회 (최근 기사 우선) ===
+func FindNewsPendingEnrichment(since time.Time, limit int) ([]models.News, error) {
+	var newsList []models.News
+	err := config.DB.
+		Select("id", "url", "original_url", "source").
+		Where("enrichment_status = ?", models.EnrichmentPending).
+		Where("created_at > ?", since).
+		Order("created_at DESC").
+		Limit(limit).
+		Find(&newsList).Error
+	return newsList, err
+}
+
+// === [신규] 방금 저장한 기사 중 보강 대기 중인 것 조회 (ExternalID 기준) ===
+func FindPendingNewsByExternalIDs(externalIDs []string) ([]models.News, error) {
+	var newsList []models.News
+	if len(externalIDs) == 0 {
+		return newsList, nil
+	}
+	err := config.DB.
+		Select("id", "url", "original_url", "source").
+		Where("external_id IN ?", externalIDs).
+		Where("enrichment_status = ?", models.EnrichmentPending).
+		Find(&newsList).Error
+	return newsList, err
+}
+
+// === [신규] 메타데이터 보강 결과 저장 ===
+// (imageURL/source 가 빈 문자열이거나 publisherID 가 nil 이면 기존 값 유지)
+func UpdateNewsEnrichment(newsID uint, imageURL string, source string, publisherID *uint, status string) error {
+	updates := map[string]interface{}{"enrichment_status": status}
+	if publisherID != nil {
+		updates["publisher_id"] = *publisherID
+	}
+	if imageURL != "" {
+		updates["image_url"] = imageURL
+	}
+	if source != "" {
+		updates["source"] = source
+	}
+	return config.DB.Model(&models.News{}).Where("id = ?", newsID).UpdateColumns(updates).Error
+}
+
+// === [신규] 기존 뉴스를 보강 완료로 표시 (enrichment_status 컬럼 최초 추가 시 1회) ===
+// (기존 기사는 수집 시점에 이미 메타데이터를 가져왔으므로 다시 보강하지 않음)
+func MarkAllNewsEnriched() (int64, error) {
+	result := config.DB.Model(&models.News{}).
+		Where("enrichment_status = ?", models.EnrichmentPending).
+		UpdateColumn("enrichment_status", models.EnrichmentDone)
+	return result.RowsAffected, result.Error
 }
 
 // === 카테고리별 뉴스 목록 조회 (페이징 포함) ===
 // (totalPages 반환을 위해 int64(totalCount)도 함께 반환)
-func GetNewsByCategory(category string, page int, size int) ([]models.News, int64, int, error) {
+// [신규] userID가 있으면 사용자가 뮤트한 기사/언론사/카테고리/키워드 제외
+// [신규] publisherID가 있으면 해당 언론사 기사만 조회
+func GetNewsByCategory(category string, page int, size int, userID uint, publisherID uint) ([]models.News, int64, int, error) {
 	var newsList []models.News
 	var totalCount int64
 
@@ -39,6 +133,13 @@ func GetNewsByCategory(category string, page int, size int) ([]models.News, int6
 		if category != "전체" {
 			query = query.Where("category = ?", category)
 		}
+		if userID != 0 {
+			query = query.Where("id NOT IN (?)", MutedNewsIDsSubQuery(userID))
+		}
+		// [신규] 언론사 필터
+		if publisherID != 0 {
+			query = query.Where("publisher_id = ?", publisherID)
+		}
 
 		// 1-2. 전체 아이템 개수(totalCount) 조회
 		if err := query.Count(&totalCount).Error; err != nil {
@@ -68,19 +169,6 @@ func GetNewsByCategory(category string, page int, size int) ([]models.News, int6
 	return newsList, totalCount, totalPages, nil
 }
 
-// === 특정 날짜 이전의 뉴스를 삭제합니다. ===
-func DeleteNewsOlderThan(cutoffDate time.Time) (int64, error) {
-	// GORM을 사용하여 created_at이 cutoffDate보다 오래된 레코드를 삭제
-	result := config.DB.Where("created_at < ?", cutoffDate).Delete(&models.News{})
-
-	if result.Error != nil {
-		return 0, result.Error
-	}
-
-	// 삭제된 행(row)의 개수를 반환
-	return result.RowsAffected, nil
-}
-
 // === Primary Key(ID)로 뉴스 1건 조회 ===
 func FindNewsByID(newsID uint) (models.News, error) {
 	var news models.News
@@ -89,6 +177,66 @@ func FindNewsByID(newsID uint) (models.News, error) {
 	return news, result.Error
 }
 
+// === [신규] 크롤링한 원문 본문 저장 ===
+// (실패한 경우에도 빈 본문과 시각을 저장해 같은 기사를 반복 크롤링하지 않음)
+// [수정] 읽기 시간(분)도 함께 저장 (0 이면 기존 값 유지)
+func UpdateNewsFullContent(newsID uint, fullContent string, extractor string, readTimeMinutes int, fetchedAt time.Time, contentURL string, etag string, lastModified string) error {
+	updates := map[string]interface{}{
+		"full_content":          fullContent,
+		"content_extractor":     extractor,
+		"content_fetched_at":    fetchedAt,
+		"content_url":           contentURL,
+		"content_etag":          etag,
+		"content_last_modified": lastModified,
+	}
+	if readTimeMinutes > 0 {
+		updates["read_time_minutes"] = readTimeMinutes
+	}
+	return config.DB.Model(&models.News{}).Where("id = ?", newsID).UpdateColumns(updates).Error
+}
+
+// === [신규] 읽기 시간 재계산 대상 (원문 본문이 있는 기사, ID 순 커서 페이징) ===
+func FindNewsWithFullContent(afterID uint, limit int) ([]models.News, error) {
+	var newsList []models.News
+	err := config.DB.
+		Select("id", "full_content", "read_time_minutes").
+		Where("id > ?", afterID).
+		Where("full_content <> ''").
+		Order("id ASC").
+		Limit(limit).
+		Find(&newsList).Error
+	return newsList, err
+}
+
+// === [신규] 원문 크롤링 대상 (recrawl 이면 이미 크롤링한 기사, 아니면 아직 안 한 기사, 최신순) ===
+func FindNewsForContentCrawl(recrawl bool, limit int) ([]models.News, error) {
+	var newsList []models.News
+	query := config.DB.Select("id", "url", "original_url", "full_content", "content_fetched_at", "read_time_minutes",
+		"content_url", "content_etag", "content_last_modified")
+	if recrawl {
+		query = query.Where("content_fetched_at IS NOT NULL")
+	} else {
+		query = query.Where("content_fetched_at IS NULL")
+	}
+	err := query.Order("created_at DESC").Limit(limit).Find(&newsList).Error
+	return newsList, err
+}
+
+// === [신규] 원문이 바뀌지 않은 경우 (304) 크롤링 시각/검증값만 갱신 ===
+func TouchNewsContentFetched(newsID uint, fetchedAt time.Time, etag string, lastModified string) error {
+	return config.DB.Model(&models.News{}).Where("id = ?", newsID).UpdateColumns(map[string]interface{}{
+		"content_fetched_at":    fetchedAt,
+		"content_etag":          etag,
+		"content_last_modified": lastModified,
+	}).Error
+}
+
+// === [신규] 읽기 시간 저장 ===
+func UpdateNewsReadTime(newsID uint, minutes int) error {
+	return config.DB.Model(&models.News{}).Where("id = ?", newsID).
+		UpdateColumn("read_time_minutes", minutes).Error
+}
+
 // === 뉴스의 조회수(view_count)를 1 증가시킴 ===
 func IncrementNewsViewCount(newsID uint) error {
 	// GORM의 UpdateColumn을 사용하여 특정 컬럼만 +1 업데이트
@@ -269,6 +417,7 @@ func GetInteractionCategoryStats(userID uint) (map[string]int64, map[string]int6
 // [신규] 추천 후보 뉴스 조회
 //   - 최근 daysWithin 일 이내
 //   - 해당 사용자가 아직 좋아요/싫어요/북마크하지 않은 뉴스만
+//   - 사용자가 뮤트한 기사/언론사/카테고리/키워드 제외
 //   - 최신순으로 최대 limit 개
 func FindNewsCandidatesForRecommendation(userID uint, daysWithin int, limit int) ([]models.News, error) {
 	var newsList []models.News
@@ -289,9 +438,49 @@ func FindNewsCandidatesForRecommendation(userID uint, daysWithin int, limit int)
 		Where("created_at > ?", cutoff).
 		Where("id NOT IN (?)", subInteractions).
 		Where("id NOT IN (?)", subBookmarks).
+		Where("id NOT IN (?)", MutedNewsIDsSubQuery(userID)).
 		Order("published_at DESC").
 		Limit(limit).
 		Find(&newsList).Error
 
 	return newsList, err
 }
+
+// [신규] 콘텐츠 기반 추천용 사용자 프로필 뉴스 조회
+//   - positive: 좋아요/북마크한 뉴스 (최근 순 최대 limit 개)
+//   - negative: 싫어요한 뉴스 (최근 순 최대 limit 개)
+func FindNewsForContentProfile(userID uint, limit int) ([]models.News, []models.News, error) {
+	var positive, negative []models.News
+
+	subLikes := config.DB.Table("news_interactions").
+		Select("news_id").
+		Where("user_id = ? AND interaction_type = ?", userID, "like")
+
+	subBookmarks := config.DB.Table("news_bookmarks").
+		Select("news_id").
+		Where("user_id = ?", userID)
+
+	err := config.DB.
+		Where("id IN (?) OR id IN (?)", subLikes, subBookmarks).
+		Order("published_at DESC").
+		Limit(limit).
+		Find(&positive).Error
+	if err != nil {
+		return nil, nil, err
+	}
+
+	subDislikes := config.DB.Table("news_interactions").
+		Select("news_id").
+		Where("user_id = ? AND interaction_type = ?", userID, "dislike")
+
+	err = config.DB.
+		Where("id IN (?)", subDislikes).
+		Order("published_at DESC").
+		Limit(limit).
+		Find(&negative).Error
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return positive, negative, nil
+}
@@ -0,0 +1,131 @@
+package repositories
+
+import (
+	"newsclip/backend/config"
+	"newsclip/backend/internal/app/models"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// === 보관 처리 결과 (배치 1회) ===
+type NewsArchiveBatchResult struct {
+	Archived            int64 // 보관본으로 옮기고 원본을 삭제한 뉴스 수
+	DeletedInteractions int64 // 함께 삭제한 좋아요/싫어요
+	DeletedNeighbors    int64 // 함께 삭제한 연관 기사 관계
+	DeletedExposures    int64 // 함께 삭제한 A/B 실험 노출 기록
+}
+
+// === 보관 대상에서 제외되는(남겨두는) 오래된 뉴스 수 ===
+type NewsRetentionKeptCounts struct {
+	Bookmarked  int64 // 북마크된 기사
+	Commented   int64 // 댓글이 달린 기사
+	ShortLinked int64 // 쇼츠가 만들어진 기사
+}
+
+// (헬퍼 함수) 보관 대상 조건
+// 기준 시각 이전에 수집되었고, 사용자 데이터(북마크/댓글/쇼츠)가 연결되지 않은 뉴스
+func newsArchiveCandidates(db *gorm.DB, cutoff time.Time) *gorm.DB {
+	return db.Model(&models.News{}).
+		Where("news.created_at < ?", cutoff).
+		Where("NOT EXISTS (SELECT 1 FROM news_bookmarks b WHERE b.news_id = news.id)").
+		Where("NOT EXISTS (SELECT 1 FROM comments c WHERE c.target_type = 'news' AND c.target_id = news.id)").
+		Where("NOT EXISTS (SELECT 1 FROM shorts s WHERE s.news_id = news.id)")
+}
+
+// === 보관 대상 뉴스 수 (dry run 용) ===
+func CountNewsArchiveCandidates(cutoff time.Time) (int64, error) {
+	var count int64
+	err := newsArchiveCandidates(config.DB, cutoff).Count(&count).Error
+	return count, err
+}
+
+// === 기준 시각 이전 뉴스 중 사용자 데이터 때문에 남겨두는 수 (항목별, 중복 포함) ===
+func CountNewsRetentionKept(cutoff time.Time) (NewsRetentionKeptCounts, error) {
+	var counts NewsRetentionKeptCounts
+	err := config.DB.Raw(`
+		SELECT
+			COUNT(*) FILTER (WHERE EXISTS (SELECT 1 FROM news_bookmarks b WHERE b.news_id = n.id)) AS bookmarked,
+			COUNT(*) FILTER (WHERE EXISTS (SELECT 1 FROM comments c WHERE c.target_type = 'news' AND c.target_id = n.id)) AS commented,
+			COUNT(*) FILTER (WHERE EXISTS (SELECT 1 FROM shorts s WHERE s.news_id = n.id)) AS short_linked
+		FROM news n
+		WHERE n.created_at < ?`, cutoff).
+		Scan(&counts).Error
+	return counts, err
+}
+
+// === 보관 대상 뉴스 1배치 처리 (트랜잭션) ===
+// 1. 요약 보관본(archived_news) 저장
+// 2. 뉴스를 참조하는 데이터 정리 (좋아요/싫어요, 연관 기사, 실험 노출)
+// 3. 원본 뉴스 삭제
+func ArchiveNewsBatch(cutoff time.Time, batchSize int, archivedAt time.Time) (NewsArchiveBatchResult, error) {
+	var result NewsArchiveBatchResult
+
+	err := config.DB.Transaction(func(tx *gorm.DB) error {
+		var ids []uint
+		if err := newsArchiveCandidates(tx, cutoff).
+			Order("news.id ASC").
+			Limit(batchSize).
+			Pluck("news.id", &ids).Error; err != nil {
+			return err
+		}
+		if len(ids) == 0 {
+			return nil
+		}
+
+		// 1. 보관본 저장 (이미 보관된 ID는 무시)
+		if err := tx.Exec(`
+			INSERT INTO archived_news (id, external_id, title, url, source, category, published_at, created_at, archived_at)
+			SELECT id, external_id, title, url, source, category, published_at, created_at, ?
+			FROM news WHERE id IN ?
+			ON CONFLICT (id) DO NOTHING`, archivedAt, ids).Error; err != nil {
+			return err
+		}
+
+		// 2. 참조 데이터 정리
+		res := tx.Where("news_id IN ?", ids).Delete(&models.NewsInteraction{})
+		if res.Error != nil {
+			return res.Error
+		}
+		result.DeletedInteractions = res.RowsAffected
+
+		res = tx.Where("news_id IN ? OR neighbor_id IN ?", ids, ids).Delete(&models.NewsNeighbor{})
+		if res.Error != nil {
+			return res.Error
+		}
+		result.DeletedNeighbors = res.RowsAffected
+
+		res = tx.Where("item_type = ? AND item_id IN ?", "news", ids).Delete(&models.ExperimentExposure{})
+		if res.Error != nil {
+			return res.Error
+		}
+		result.DeletedExposures = res.RowsAffected
+
+		if err := tx.Where("news_id IN ?", ids).Delete(&models.NewsTag{}).Error; err != nil {
+			return err
+		}
+
+		// 3. 원본 삭제
+		res = tx.Where("id IN ?", ids).Delete(&models.News{})
+		if res.Error != nil {
+			return res.Error
+		}
+		result.Archived = res.RowsAffected
+		return nil
+	})
+
+	return result, err
+}
+
+// === 보관본 중 기준 시각 이전에 보관된 것 영구 삭제 ===
+func PurgeArchivedNewsBefore(cutoff time.Time) (int64, error) {
+	result := config.DB.Where("archived_at < ?", cutoff).Delete(&models.ArchivedNews{})
+	return result.RowsAffected, result.Error
+}
+
+// === 보관본 1건 조회 (삭제된 뉴스의 기존 링크 대응) ===
+func FindArchivedNewsByID(newsID uint) (models.ArchivedNews, error) {
+	var archived models.ArchivedNews
+	err := config.DB.First(&archived, newsID).Error
+	return archived, err
+}
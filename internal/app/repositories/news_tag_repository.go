@@ -0,0 +1,93 @@
+package repositories
+
+import (
+	"math"
+	"newsclip/backend/config"
+	"newsclip/backend/internal/app/models"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// === 태깅 대기 중인 뉴스 조회 (최근 기사 우선) ===
+func FindNewsPendingTagging(limit int) ([]models.News, error) {
+	var newsList []models.News
+	err := config.DB.
+		Select("id", "title", "content", "full_content").
+		Where("tagged_at IS NULL").
+		Order("created_at DESC").
+		Limit(limit).
+		Find(&newsList).Error
+	return newsList, err
+}
+
+// === 태깅 IDF 계산용 최근 기사 조회 ===
+// 태그 추출과 같은 본문을 쓰도록 Content 에 원문 본문(없으면 요약)을 담아 반환
+func FindNewsForTagCorpus(since time.Time, limit int) ([]models.News, error) {
+	var newsList []models.News
+	err := config.DB.
+		Select("id, title, COALESCE(NULLIF(full_content, ''), content) AS content").
+		Where("created_at > ?", since).
+		Order("created_at DESC").
+		Limit(limit).
+		Find(&newsList).Error
+	return newsList, err
+}
+
+// === 기사 태그 교체 저장 + 태깅 시각 기록 ===
+func ReplaceNewsTags(newsID uint, tags []models.NewsTag, taggedAt time.Time) error {
+	return config.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("news_id = ?", newsID).Delete(&models.NewsTag{}).Error; err != nil {
+			return err
+		}
+		if len(tags) > 0 {
+			if err := tx.Create(&tags).Error; err != nil {
+				return err
+			}
+		}
+		return tx.Model(&models.News{}).Where("id = ?", newsID).
+			UpdateColumn("tagged_at", taggedAt).Error
+	})
+}
+
+// === 기사 태그 조회 (개체명 → 키워드, 점수순) ===
+func FindTagsByNewsID(newsID uint) ([]models.NewsTag, error) {
+	var tags []models.NewsTag
+	err := config.DB.
+		Where("news_id = ?", newsID).
+		Order("CASE WHEN tag_type = 'keyword' THEN 1 ELSE 0 END, score DESC, tag ASC").
+		Find(&tags).Error
+	return tags, err
+}
+
+// === 태그별 뉴스 목록 조회 (페이징, 최신순) ===
+// 태그는 대소문자 구분 없이 비교 ("ai" 로 "AI" 태그 조회 가능)
+// userID가 있으면 사용자가 뮤트한 기사/언론사/카테고리/키워드 제외
+func GetNewsByTag(tag string, page int, size int, userID uint) ([]models.News, int64, int, error) {
+	var newsList []models.News
+	var totalCount int64
+
+	err := config.DB.Transaction(func(tx *gorm.DB) error {
+		query := tx.Model(&models.News{}).
+			Where("id IN (?)", tx.Model(&models.NewsTag{}).Select("news_id").Where("LOWER(tag) = LOWER(?)", tag))
+		if userID != 0 {
+			query = query.Where("id NOT IN (?)", MutedNewsIDsSubQuery(userID))
+		}
+
+		if err := query.Count(&totalCount).Error; err != nil {
+			return err
+		}
+
+		offset := (page - 1) * size
+		return query.Order("published_at DESC").
+			Limit(size).
+			Offset(offset).
+			Find(&newsList).Error
+	})
+	if err != nil {
+		return nil, 0, 0, err
+	}
+
+	totalPages := int(math.Ceil(float64(totalCount) / float64(size)))
+	return newsList, totalCount, totalPages, nil
+}
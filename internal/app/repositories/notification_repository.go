@@ -0,0 +1,27 @@
+package repositories
+
+import (
+	"newsclip/backend/config"
+	"newsclip/backend/internal/app/models"
+)
+
+// === 키워드 알림을 등록한 사용자 ID (키워드 중 하나라도 일치, 대소문자 무시) ===
+func FindUserIDsByAlertKeywords(keywords []string) ([]uint, error) {
+	var userIDs []uint
+	if len(keywords) == 0 {
+		return userIDs, nil
+	}
+	err := config.DB.Model(&models.AlertKeyword{}).
+		Distinct("user_id").
+		Where("LOWER(keyword) IN ?", keywords).
+		Pluck("user_id", &userIDs).Error
+	return userIDs, err
+}
+
+// === 알림 일괄 생성 ===
+func CreateNotifications(notifications []models.Notification) error {
+	if len(notifications) == 0 {
+		return nil
+	}
+	return config.DB.CreateInBatches(&notifications, 100).Error
+}
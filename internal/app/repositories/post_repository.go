@@ -77,6 +77,75 @@ func FindPostByID(postID uint) (models.Post, error) {
 	return post, err
 }
 
+// 게시글 단건 조회 (작성자, 이미지 포함 - 상세 조회용)
+func FindPostWithRelations(postID uint) (models.Post, error) {
+	var post models.Post
+	err := config.DB.
+		Preload("User").
+		Preload("Images").
+		First(&post, postID).Error
+	return post, err
+}
+
+// 게시글 조회수 +1
+func IncrementPostViewCount(postID uint) error {
+	return config.DB.Model(&models.Post{}).Where("id = ?", postID).
+		UpdateColumn("view_count", gorm.Expr("view_count + 1")).Error
+}
+
+// 게시글 수정 (트랜잭션)
+//   - 제목/본문/카테고리가 바뀔 때만 수정 전 내용을 이력으로 남기고 (이미지만 바꾸면 이력 없음)
+//   - 변경된 필드와 edited_at을 갱신
+//   - replaceImages가 true이면 기존 이미지를 모두 지우고 imageURLs로 교체
+func UpdatePostWithHistory(post *models.Post, updates map[string]interface{}, replaceImages bool, imageURLs []string) error {
+	return config.DB.Transaction(func(tx *gorm.DB) error {
+		// 1. 수정 전 내용 보관 (제목/본문/카테고리가 바뀐 경우만)
+		_, titleChanged := updates["title"]
+		_, contentChanged := updates["content"]
+		_, categoryChanged := updates["category"]
+		if titleChanged || contentChanged || categoryChanged {
+			history := models.PostEditHistory{
+				PostID:   post.ID,
+				Title:    post.Title,
+				Content:  post.Content,
+				Category: post.Category,
+			}
+			if err := tx.Create(&history).Error; err != nil {
+				return err
+			}
+		}
+
+		// 2. 게시글 필드 갱신
+		if err := tx.Model(post).Updates(updates).Error; err != nil {
+			return err
+		}
+
+		// 3. 이미지 교체
+		if replaceImages {
+			if err := tx.Where("post_id = ?", post.ID).Delete(&models.PostImage{}).Error; err != nil {
+				return err
+			}
+			for _, img := range imageURLs {
+				if err := tx.Create(&models.PostImage{PostID: post.ID, ImageURL: img}).Error; err != nil {
+					return err
+				}
+			}
+		}
+
+		return nil
+	})
+}
+
+// 게시글 수정 이력 조회 (최신순)
+func GetPostEditHistories(postID uint) ([]models.PostEditHistory, error) {
+	var histories []models.PostEditHistory
+	err := config.DB.
+		Where("post_id = ?", postID).
+		Order("created_at DESC").
+		Find(&histories).Error
+	return histories, err
+}
+
 // 게시글 삭제
 func DeletePost(post *models.Post) error {
 	return config.DB.Delete(post).Error
@@ -0,0 +1,58 @@
+package repositories
+
+import (
+	"newsclip/backend/config"
+	"newsclip/backend/internal/app/models"
+
+	"gorm.io/gorm/clause"
+)
+
+// === 언론사 레지스트리 동기화 (이름 기준 upsert) ===
+func UpsertPublishers(publishers []models.Publisher) error {
+	if len(publishers) == 0 {
+		return nil
+	}
+	return config.DB.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "name"}},
+		DoUpdates: clause.AssignmentColumns([]string{"domains", "aliases", "logo_url", "reliability_tier", "updated_at"}),
+	}).Create(&publishers).Error
+}
+
+// === 전체 언론사 조회 (이름순) ===
+func FindAllPublishers() ([]models.Publisher, error) {
+	var publishers []models.Publisher
+	err := config.DB.Order("name ASC").Find(&publishers).Error
+	return publishers, err
+}
+
+// === 언론사별 뉴스 수 ===
+func CountNewsByPublisher() (map[uint]int64, error) {
+	var rows []struct {
+		PublisherID uint
+		Count       int64
+	}
+	err := config.DB.Model(&models.News{}).
+		Select("publisher_id, COUNT(*) AS count").
+		Where("publisher_id IS NOT NULL").
+		Group("publisher_id").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	counts := make(map[uint]int64, len(rows))
+	for _, r := range rows {
+		counts[r.PublisherID] = r.Count
+	}
+	return counts, nil
+}
+
+// === 언론사가 매칭되지 않은 기존 뉴스에 언론사 연결 ===
+// keys: 소문자로 비교할 Source 값 목록 (정식 이름, 별칭, 도메인, www.도메인)
+func AssignPublisherToUnmatchedNews(publisher models.Publisher, keys []string) (int64, error) {
+	result := config.DB.Model(&models.News{}).
+		Where("publisher_id IS NULL").
+		Where("LOWER(source) IN ?", keys).
+		UpdateColumns(map[string]interface{}{"publisher_id": publisher.ID, "source": publisher.Name})
+	return result.RowsAffected, result.Error
+}
@@ -3,13 +3,15 @@ package repositories
 import (
 	"newsclip/backend/config"
 	"newsclip/backend/internal/app/models"
+	"time"
 
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
-// 최신 쇼츠 목록 조회 (커서 페이징 적용)
+// 최신 쇼츠 목록 조회 (커서 페이징 적용, 로그인 유저는 시청한 쇼츠 제외)
 // cursorID가 0이면 가장 최신부터, 0보다 크면 그 ID보다 작은 것부터 조회
-func FindRecentShorts(limit int, cursorID uint) ([]models.Short, error) {
+func FindRecentShorts(limit int, cursorID uint, userID uint) ([]models.Short, error) {
 	var shorts []models.Short
 
 	query := config.DB.Model(&models.Short{})
@@ -19,6 +21,17 @@ func FindRecentShorts(limit int, cursorID uint) ([]models.Short, error) {
 		query = query.Where("id < ?", cursorID)
 	}
 
+	// [신규] 로그인 유저라면 뮤트한 기사/언론사/카테고리/키워드의 쇼츠와
+	// 이미 시청한 쇼츠 제외 (맞춤 피드와 동일, 시청 기록은 주로 커서보다 최신인 쇼츠에 쌓이므로 페이징이 흔들리지 않음)
+	if userID != 0 {
+		query = query.Where("news_id NOT IN (?)", MutedNewsIDsSubQuery(userID))
+
+		subViews := config.DB.Table("short_views").
+			Select("short_id").
+			Where("user_id = ?", userID)
+		query = query.Where("id NOT IN (?)", subViews)
+	}
+
 	// ID 내림차순 (최신순) 정렬 후 Limit
 	result := query.Order("id DESC").Limit(limit).Find(&shorts)
 
@@ -41,6 +54,24 @@ func FindShortByID(shortID uint) (models.Short, error) {
 	return short, result.Error
 }
 
+// 쇼츠 상세 조회 (원본 뉴스 포함)
+func FindShortWithNews(shortID uint) (models.Short, error) {
+	var short models.Short
+	result := config.DB.Preload("News").First(&short, shortID)
+	return short, result.Error
+}
+
+// 쇼츠 comment_count를 통합 댓글 테이블 기준으로 다시 계산 (컬럼 추가 시 백필용)
+func RecountShortCommentCounts() (int64, error) {
+	result := config.DB.Exec(`
+	UPDATE shorts AS s
+	SET comment_count = (
+		SELECT COUNT(*) FROM comments AS c
+		WHERE c.target_type = 'short' AND c.target_id = s.id AND c.is_deleted = false
+	)`)
+	return result.RowsAffected, result.Error
+}
+
 // === 쇼츠 상호작용 처리를 위한 5개 함수 ===
 
 // 1. 기존 상호작용 조회
@@ -72,3 +103,155 @@ func UpdateShortCounts(tx *gorm.DB, shortID uint, likeDelta int, dislikeDelta in
 		"dislike_count": gorm.Expr("dislike_count + ?", dislikeDelta),
 	}).Error
 }
+
+// ====================================================================
+//  아래부터는 "맞춤 쇼츠 피드"를 위한 통계/후보 조회용 함수들
+// ====================================================================
+
+// 사용자 쇼츠 좋아요/싫어요의 (원본 뉴스) 카테고리 통계
+//
+//	likeStats[category] / dislikeStats[category]
+func GetShortInteractionCategoryStats(userID uint) (map[string]int64, map[string]int64, error) {
+	type resultRow struct {
+		Category        string
+		InteractionType string
+		Count           int64
+	}
+
+	var rows []resultRow
+	err := config.DB.Table("short_interactions AS si").
+		Joins("JOIN shorts AS s ON s.id = si.short_id").
+		Joins("JOIN news AS n ON n.id = s.news_id").
+		Where("si.user_id = ?", userID).
+		Select("n.category AS category, si.interaction_type AS interaction_type, COUNT(*) AS count").
+		Group("n.category, si.interaction_type").
+		Scan(&rows).Error
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	likeStats := make(map[string]int64)
+	dislikeStats := make(map[string]int64)
+
+	for _, r := range rows {
+		if r.InteractionType == "like" {
+			likeStats[r.Category] = r.Count
+		} else if r.InteractionType == "dislike" {
+			dislikeStats[r.Category] = r.Count
+		}
+	}
+
+	return likeStats, dislikeStats, nil
+}
+
+// 맞춤 피드 후보 쇼츠 조회
+//   - 최근 daysWithin 일 이내 생성
+//   - 해당 사용자가 이미 본(시청했거나 좋아요/싫어요한) 쇼츠 제외
+//   - 사용자가 뮤트한 기사/언론사/카테고리/키워드의 쇼츠 제외
+//   - 최신순으로 최대 limit 개 (원본 뉴스 포함)
+func FindShortCandidatesForUser(userID uint, daysWithin int, limit int) ([]models.Short, error) {
+	var shorts []models.Short
+
+	cutoff := time.Now().AddDate(0, 0, -daysWithin)
+
+	// 서브쿼리: 사용자가 상호작용한 쇼츠 ID
+	subInteractions := config.DB.Table("short_interactions").
+		Select("short_id").
+		Where("user_id = ?", userID)
+
+	// 서브쿼리: 사용자가 이미 시청한 쇼츠 ID
+	subViews := config.DB.Table("short_views").
+		Select("short_id").
+		Where("user_id = ?", userID)
+
+	err := config.DB.Preload("News").
+		Where("created_at > ?", cutoff).
+		Where("id NOT IN (?)", subInteractions).
+		Where("id NOT IN (?)", subViews).
+		Where("news_id NOT IN (?)", MutedNewsIDsSubQuery(userID)).
+		Order("id DESC").
+		Limit(limit).
+		Find(&shorts).Error
+
+	return shorts, err
+}
+
+// ID 목록으로 쇼츠 조회 (순서는 보장하지 않음)
+func FindShortsByIDs(shortIDs []uint) ([]models.Short, error) {
+	var shorts []models.Short
+	if len(shortIDs) == 0 {
+		return shorts, nil
+	}
+	result := config.DB.Where("id IN ?", shortIDs).Find(&shorts)
+	return shorts, result.Error
+}
+
+// ====================================================================
+//  쇼츠 시청 이벤트 집계
+// ====================================================================
+
+// 쇼츠 1개에 대한 시청 이벤트 집계값
+type ShortViewStats struct {
+	ShortID     uint
+	Views       int
+	DwellMs     int64
+	Completions int
+	Skips       int
+
+	Counted  bool              // false 면 쇼츠 카운터는 그대로 두고 시청 기록만 갱신 (중복 시청)
+	Counters ShortViewCounters // Counted 일 때 쇼츠 카운터에 더할 값
+}
+
+// 쇼츠 캐시 카운터 증가분
+type ShortViewCounters struct {
+	Views       int
+	DwellMs     int64
+	Completions int
+	Skips       int
+}
+
+// 시청 이벤트 집계값을 쇼츠 카운터에 반영하고, 로그인 유저라면 시청 기록(short_views)을 갱신
+// (쇼츠 카운터는 Counted 인 항목만 Counters 만큼 증가)
+func ApplyShortViewStats(userID uint, stats []ShortViewStats) error {
+	return config.DB.Transaction(func(tx *gorm.DB) error {
+		for _, st := range stats {
+			// 1. 쇼츠 캐시 카운터 증가
+			if st.Counted {
+				if err := tx.Model(&models.Short{}).Where("id = ?", st.ShortID).Updates(map[string]interface{}{
+					"view_count":       gorm.Expr("view_count + ?", st.Counters.Views),
+					"total_dwell_ms":   gorm.Expr("total_dwell_ms + ?", st.Counters.DwellMs),
+					"completion_count": gorm.Expr("completion_count + ?", st.Counters.Completions),
+					"skip_count":       gorm.Expr("skip_count + ?", st.Counters.Skips),
+				}).Error; err != nil {
+					return err
+				}
+			}
+
+			if userID == 0 {
+				continue
+			}
+
+			// 2. 사용자 시청 기록 Upsert
+			view := models.ShortView{
+				UserID:    userID,
+				ShortID:   st.ShortID,
+				ViewCount: st.Views,
+				DwellMs:   st.DwellMs,
+				Completed: st.Completions > 0,
+			}
+			if err := tx.Clauses(clause.OnConflict{
+				Columns: []clause.Column{{Name: "user_id"}, {Name: "short_id"}},
+				DoUpdates: clause.Assignments(map[string]interface{}{
+					"view_count": gorm.Expr("short_views.view_count + EXCLUDED.view_count"),
+					"dwell_ms":   gorm.Expr("short_views.dwell_ms + EXCLUDED.dwell_ms"),
+					"completed":  gorm.Expr("short_views.completed OR EXCLUDED.completed"),
+					"updated_at": gorm.Expr("EXCLUDED.updated_at"),
+				}),
+			}).Create(&view).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
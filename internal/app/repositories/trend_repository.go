@@ -0,0 +1,130 @@
+package repositories
+
+import (
+	"newsclip/backend/config"
+	"newsclip/backend/internal/app/models"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// 태그별 기사 수 집계 결과
+type TagArticleCount struct {
+	Tag          string
+	TagType      string
+	ArticleCount int
+	SourceCount  int
+}
+
+// === 기간 내 수집된 기사의 태그별 기사 수/언론사 수 ===
+// (수집 시각 created_at 기준, from <= created_at < to)
+func CountTagArticles(from time.Time, to time.Time) ([]TagArticleCount, error) {
+	var rows []TagArticleCount
+	err := config.DB.Table("news_tags AS t").
+		Select("t.tag, MIN(t.tag_type) AS tag_type, COUNT(DISTINCT n.id) AS article_count, COUNT(DISTINCT COALESCE(CAST(n.publisher_id AS TEXT), n.source)) AS source_count").
+		Joins("JOIN news AS n ON n.id = t.news_id").
+		Where("n.created_at >= ? AND n.created_at < ?", from, to).
+		Group("t.tag").
+		Scan(&rows).Error
+	return rows, err
+}
+
+// 태그-기사 연결
+type TagNewsRow struct {
+	Tag    string
+	NewsID uint
+	Source string
+}
+
+// === 기간 내 수집된 기사 중 주어진 태그가 달린 기사 (최신 발행순) ===
+func FindTagNews(tags []string, from time.Time) ([]TagNewsRow, error) {
+	var rows []TagNewsRow
+	if len(tags) == 0 {
+		return rows, nil
+	}
+	err := config.DB.Table("news_tags AS t").
+		Select("t.tag, n.id AS news_id, n.source").
+		Joins("JOIN news AS n ON n.id = t.news_id").
+		Where("t.tag IN ?", tags).
+		Where("n.created_at >= ?", from).
+		Order("n.published_at DESC").
+		Scan(&rows).Error
+	return rows, err
+}
+
+// === 감지 결과 저장 ===
+// [수정] 감지된 이슈가 없어도 실행 기록은 남김 (topics 의 DetectedAt 은 detectedAt 과 같아야 함)
+func CreateTrendTopics(detectedAt time.Time, topics []models.TrendTopic) error {
+	return config.DB.Transaction(func(tx *gorm.DB) error {
+		run := models.TrendDetectionRun{DetectedAt: detectedAt, TopicCount: len(topics)}
+		if err := tx.Create(&run).Error; err != nil {
+			return err
+		}
+		if len(topics) == 0 {
+			return nil
+		}
+		return tx.Create(&topics).Error
+	})
+}
+
+// === 가장 최근 감지 결과 (급상승 배율순) ===
+// [수정] 가장 최근 실행 기록 기준 (최근 실행에서 감지된 이슈가 없으면 빈 목록)
+func FindLatestTrendTopics(limit int) ([]models.TrendTopic, error) {
+	var topics []models.TrendTopic
+	var latest *time.Time
+	if err := config.DB.Model(&models.TrendDetectionRun{}).Select("MAX(detected_at)").Scan(&latest).Error; err != nil {
+		return nil, err
+	}
+	if latest == nil {
+		return topics, nil
+	}
+
+	err := config.DB.
+		Where("detected_at = ?", *latest).
+		Order("is_breaking DESC, burst_score DESC, article_count DESC").
+		Limit(limit).
+		Find(&topics).Error
+	return topics, err
+}
+
+// === 같은 이슈의 속보 알림을 최근에 보냈는지 ===
+func HasNotifiedTrendTopic(topic string, since time.Time) (bool, error) {
+	var count int64
+	err := config.DB.Model(&models.TrendTopic{}).
+		Where("topic = ? AND notified_at >= ?", topic, since).
+		Count(&count).Error
+	return count > 0, err
+}
+
+// === 속보 알림 발송 기록 ===
+func MarkTrendTopicNotified(topicID uint, notifiedAt time.Time) error {
+	return config.DB.Model(&models.TrendTopic{}).Where("id = ?", topicID).
+		UpdateColumn("notified_at", notifiedAt).Error
+}
+
+// === 오래된 감지 결과 삭제 ===
+// [수정] 실행 기록도 함께 삭제
+func DeleteTrendTopicsBefore(cutoff time.Time) (int64, error) {
+	result := config.DB.Where("detected_at < ?", cutoff).Delete(&models.TrendTopic{})
+	if result.Error != nil {
+		return 0, result.Error
+	}
+	if err := config.DB.Where("detected_at < ?", cutoff).Delete(&models.TrendDetectionRun{}).Error; err != nil {
+		return result.RowsAffected, err
+	}
+	return result.RowsAffected, nil
+}
+
+// === ID 목록으로 뉴스 조회 (사용자 뮤트 반영, 순서는 보장하지 않음) ===
+func FindNewsByIDsForUser(ids []uint, userID uint) ([]models.News, error) {
+	var newsList []models.News
+	if len(ids) == 0 {
+		return newsList, nil
+	}
+	query := config.DB.Where("id IN ?", ids)
+	if userID != 0 {
+		query = query.Where("id NOT IN (?)", MutedNewsIDsSubQuery(userID))
+	}
+	err := query.Find(&newsList).Error
+	return newsList, err
+}
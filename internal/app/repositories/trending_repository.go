@@ -0,0 +1,92 @@
+package repositories
+
+import (
+	"fmt"
+	"newsclip/backend/config"
+	"newsclip/backend/internal/app/models"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// ====================================================================
+//  인기 급상승(트렌딩) 점수
+//    (조회*View + 좋아요*Like + 댓글*Comment + 북마크*Bookmark) / (경과시간(h) + 2)^Gravity
+// ====================================================================
+
+// 트렌딩 점수 가중치
+type TrendingWeights struct {
+	View     float64
+	Like     float64
+	Comment  float64
+	Bookmark float64
+	Gravity  float64 // 클수록 오래된 기사의 점수가 빠르게 감소
+}
+
+// (헬퍼 함수) 트렌딩 점수 SQL 식 (news AS n, 북마크 수 서브쿼리 AS b 기준)
+func trendingScoreSQL(w TrendingWeights) string {
+	return fmt.Sprintf(`(
+		n.view_count * %f + n.like_count * %f + n.comment_count * %f + COALESCE(b.cnt, 0) * %f
+	) / POWER(GREATEST(EXTRACT(EPOCH FROM (NOW() - n.published_at)) / 3600.0, 0) + 2.0, %f)`,
+		w.View, w.Like, w.Comment, w.Bookmark, w.Gravity)
+}
+
+// (헬퍼 함수) 기사별 북마크 수 조인
+func joinBookmarkCounts(query *gorm.DB) *gorm.DB {
+	return query.Joins("LEFT JOIN (SELECT news_id, COUNT(*) AS cnt FROM news_bookmarks GROUP BY news_id) AS b ON b.news_id = n.id")
+}
+
+// [신규] 트렌딩 뉴스 조회 (점수 내림차순)
+//   - since 이후 발행된 기사만
+//   - category가 비어 있거나 "전체"면 전체 카테고리
+//   - userID가 있으면 사용자가 뮤트한 기사/언론사/카테고리/키워드 제외
+func FindTrendingNews(category string, since time.Time, w TrendingWeights, userID uint, limit int) ([]models.News, error) {
+	var newsList []models.News
+
+	query := joinBookmarkCounts(config.DB.Table("news AS n")).
+		Select("n.*").
+		Where("n.published_at >= ?", since)
+
+	if category != "" && category != "전체" {
+		query = query.Where("n.category = ?", category)
+	}
+	if userID != 0 {
+		query = query.Where("n.id NOT IN (?)", MutedNewsIDsSubQuery(userID))
+	}
+
+	err := query.
+		Order(trendingScoreSQL(w) + " DESC, n.published_at DESC").
+		Limit(limit).
+		Find(&newsList).Error
+
+	return newsList, err
+}
+
+// [신규] 뉴스 ID 목록의 트렌딩 점수 조회
+//
+//	key: news ID, value: 트렌딩 점수
+func GetTrendingScores(newsIDs []uint, w TrendingWeights) (map[uint]float64, error) {
+	scores := make(map[uint]float64, len(newsIDs))
+	if len(newsIDs) == 0 {
+		return scores, nil
+	}
+
+	type resultRow struct {
+		ID    uint
+		Score float64
+	}
+
+	var rows []resultRow
+	err := joinBookmarkCounts(config.DB.Table("news AS n")).
+		Select("n.id AS id, "+trendingScoreSQL(w)+" AS score").
+		Where("n.id IN ?", newsIDs).
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	for _, r := range rows {
+		scores[r.ID] = r.Score
+	}
+	return scores, nil
+}
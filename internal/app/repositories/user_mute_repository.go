@@ -0,0 +1,71 @@
+package repositories
+
+import (
+	"newsclip/backend/config"
+	"newsclip/backend/internal/app/models"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// ====================================================================
+//  사용자 "관심 없음" 피드백 (user_mutes)
+// ====================================================================
+
+// [신규] 뮤트 추가 (이미 같은 뮤트가 있으면 기존 레코드를 그대로 반환)
+func CreateUserMute(mute *models.UserMute) error {
+	err := config.DB.Clauses(clause.OnConflict{DoNothing: true}).Create(mute).Error
+	if err != nil {
+		return err
+	}
+	if mute.ID != 0 {
+		return nil
+	}
+
+	// 충돌로 삽입되지 않은 경우 기존 레코드 조회
+	return config.DB.
+		Where("user_id = ? AND mute_type = ? AND value = ?", mute.UserID, mute.MuteType, mute.Value).
+		First(mute).Error
+}
+
+// [신규] 사용자 뮤트 목록 조회 (최신순)
+func FindUserMutes(userID uint) ([]models.UserMute, error) {
+	var mutes []models.UserMute
+	err := config.DB.Where("user_id = ?", userID).
+		Order("created_at DESC").
+		Find(&mutes).Error
+	return mutes, err
+}
+
+// [신규] 뮤트 해제 (본인 것만, 삭제된 행 수 반환)
+func DeleteUserMute(userID, muteID uint) (int64, error) {
+	result := config.DB.Where("id = ? AND user_id = ?", muteID, userID).Delete(&models.UserMute{})
+	return result.RowsAffected, result.Error
+}
+
+// [신규] 사용자가 뮤트한 조건에 걸리는 뉴스 ID 서브쿼리
+// (숨긴 기사 / 뮤트한 언론사 / 뮤트한 카테고리 / 제목에 뮤트 키워드 포함)
+// 언론사 뮤트는 레지스트리 언론사로 먼저 비교하고, 매칭되지 않은 언론사는 이름으로 비교
+//
+//	사용 예: query.Where("id NOT IN (?)", MutedNewsIDsSubQuery(userID))
+func MutedNewsIDsSubQuery(userID uint) *gorm.DB {
+	return config.DB.Table("news AS mn").
+		Select("mn.id").
+		Joins(`JOIN user_mutes AS um ON um.user_id = ? AND (
+			(um.mute_type = 'news' AND um.value = CAST(mn.id AS TEXT)) OR
+			(um.mute_type = 'source' AND (um.publisher_id = mn.publisher_id OR LOWER(um.value) = LOWER(mn.source))) OR
+			(um.mute_type = 'category' AND um.value = mn.category) OR
+			(um.mute_type = 'keyword' AND STRPOS(LOWER(mn.title), LOWER(um.value)) > 0)
+		)`, userID)
+}
+
+// [신규] 언론사가 연결되지 않은 기존 언론사 뮤트에 언론사 연결
+// keys: 소문자로 비교할 뮤트 값 목록 (정식 이름, 별칭, 도메인, www.도메인)
+// (value 는 그대로 두어 같은 사용자의 다른 표기 뮤트와 고유 인덱스가 충돌하지 않도록 함)
+func AssignPublisherToSourceMutes(publisher models.Publisher, keys []string) (int64, error) {
+	result := config.DB.Model(&models.UserMute{}).
+		Where("mute_type = ? AND publisher_id IS NULL", "source").
+		Where("LOWER(value) IN ?", keys).
+		UpdateColumn("publisher_id", publisher.ID)
+	return result.RowsAffected, result.Error
+}
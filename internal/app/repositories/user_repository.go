@@ -53,7 +53,7 @@ func GetUserProfile(userID uint) (map[string]interface{}, error) {
 	var likeCount int64
 
 	config.DB.Model(&models.Post{}).Where("user_id = ?", userID).Count(&postCount)
-	config.DB.Model(&models.PostComment{}).Where("user_id = ?", userID).Count(&commentCount)
+	config.DB.Model(&models.Comment{}).Where("user_id = ? AND target_type = ? AND is_deleted = false", userID, "post").Count(&commentCount)
 	// config.DB.Model(&models.PostLike{}).Where("user_id = ?", userID).Count(&likeCount)
 
 	data := map[string]interface{}{
@@ -92,31 +92,16 @@ type UserStats struct {
 // === 사용자 활동 통계 계산 함수 ===
 func GetUserStats(userID uint) (UserStats, error) {
 	var stats UserStats
-	var count int64
 
 	// 1. 내가 쓴 게시글 수 (posts)
 	if err := config.DB.Model(&models.Post{}).Where("user_id = ?", userID).Count(&stats.PostCount).Error; err != nil {
 		return stats, err
 	}
 
-	// 2. 내가 쓴 댓글 수 합계 (news + shorts + posts)
-	// 2-1. 뉴스 댓글
-	if err := config.DB.Model(&models.NewsComment{}).Where("user_id = ?", userID).Count(&count).Error; err != nil {
+	// 2. 내가 쓴 댓글 수 합계 (news + shorts + posts, 통합 댓글 테이블)
+	if err := config.DB.Model(&models.Comment{}).Where("user_id = ? AND is_deleted = false", userID).Count(&stats.CommentCount).Error; err != nil {
 		return stats, err
 	}
-	stats.CommentCount += count
-
-	// 2-2. 쇼츠 댓글
-	if err := config.DB.Model(&models.ShortComment{}).Where("user_id = ?", userID).Count(&count).Error; err != nil {
-		return stats, err
-	}
-	stats.CommentCount += count
-
-	// 2-3. 게시글 댓글
-	if err := config.DB.Model(&models.PostComment{}).Where("user_id = ?", userID).Count(&count).Error; err != nil {
-		return stats, err
-	}
-	stats.CommentCount += count
 
 	// 3. 내가 쓴 게시글이 받은 총 좋아요 수
 	// (posts 테이블의 like_count 컬럼의 합)
@@ -47,12 +47,18 @@ func SetupRouter() *gin.Engine {
 
 		news := v1.Group("/news")
 		{
-			news.GET("/", controllers.GetNewsList)
-			news.GET("/:newsId", controllers.GetNewsDetail)
+			news.GET("/", middlewares.AuthMiddlewareOptional(), controllers.GetNewsList)
+			news.GET("/trending", middlewares.AuthMiddlewareOptional(), controllers.GetTrendingNews)
+			news.GET("/:newsId", middlewares.AuthMiddlewareOptional(), controllers.GetNewsDetail)
+			news.GET("/:newsId/related", controllers.GetRelatedNews)
 			news.POST("/:newsId/interact", middlewares.AuthMiddleware(), controllers.InteractNews)
 			news.POST("/:newsId/bookmark", middlewares.AuthMiddleware(), controllers.BookmarkNews)
-			news.GET("/:newsId/comments", setTarget("news"), controllers.GetComments)
+			news.GET("/:newsId/comments", middlewares.AuthMiddlewareOptional(), setTarget("news"), controllers.GetComments)
 			news.POST("/:newsId/comments", middlewares.AuthMiddleware(), setTarget("news"), controllers.CreateComment)
+			news.GET("/:newsId/comments/:commentId/replies", middlewares.AuthMiddlewareOptional(), setTarget("news"), controllers.GetCommentReplies)
+			news.PATCH("/:newsId/comments/:commentId", middlewares.AuthMiddleware(), setTarget("news"), controllers.UpdateComment)
+			news.DELETE("/:newsId/comments/:commentId", middlewares.AuthMiddleware(), setTarget("news"), controllers.DeleteComment)
+			news.POST("/:newsId/comments/:commentId/interact", middlewares.AuthMiddleware(), setTarget("news"), controllers.InteractComment)
 
 			// GET /v1/news/recommend?size=20
 			news.GET("/recommendations/popup", middlewares.AuthMiddleware(), controllers.GetRecommendedNews)
@@ -61,9 +67,15 @@ func SetupRouter() *gin.Engine {
 		shorts := v1.Group("/shorts")
 		{
 			shorts.GET("/", middlewares.AuthMiddlewareOptional(), controllers.GetShortsFeed)
+			shorts.GET("/:shortId", middlewares.AuthMiddlewareOptional(), controllers.GetShortDetail)
+			shorts.POST("/events", middlewares.AuthMiddlewareOptional(), controllers.RecordShortViewEvents)
 			shorts.POST("/:shortId/interact", middlewares.AuthMiddleware(), controllers.InteractShort)
-			shorts.GET("/:shortId/comments", setTarget("short"), controllers.GetComments)
+			shorts.GET("/:shortId/comments", middlewares.AuthMiddlewareOptional(), setTarget("short"), controllers.GetComments)
 			shorts.POST("/:shortId/comments", middlewares.AuthMiddleware(), setTarget("short"), controllers.CreateComment)
+			shorts.GET("/:shortId/comments/:commentId/replies", middlewares.AuthMiddlewareOptional(), setTarget("short"), controllers.GetCommentReplies)
+			shorts.PATCH("/:shortId/comments/:commentId", middlewares.AuthMiddleware(), setTarget("short"), controllers.UpdateComment)
+			shorts.DELETE("/:shortId/comments/:commentId", middlewares.AuthMiddleware(), setTarget("short"), controllers.DeleteComment)
+			shorts.POST("/:shortId/comments/:commentId/interact", middlewares.AuthMiddleware(), setTarget("short"), controllers.InteractComment)
 		}
 
 		me := v1.Group("/me", middlewares.AuthMiddleware())
@@ -75,25 +87,68 @@ func SetupRouter() *gin.Engine {
 			// 7.4 선호 카테고리 조회
 			me.GET("/preferences/categories", controllers.GetPreferredCategories)
 
+			// 온보딩 완료 (선호 카테고리 + 시드 기사)
+			me.POST("/onboarding", controllers.CompleteOnboarding)
+
 			// 7.5 선호 카테고리 설정
 			me.PUT("/preferences/categories", controllers.SetPreferredCategories)
 			me.GET("/posts", controllers.GetMyPosts)
 			me.GET("/comments", controllers.GetMyComments)
 
+			// "관심 없음" 피드백 (기사 숨김 / 언론사·카테고리·키워드 뮤트)
+			me.GET("/mutes", controllers.GetMyMutes)
+			me.POST("/mutes", controllers.AddMyMute)
+			me.DELETE("/mutes/:muteId", controllers.RemoveMyMute)
+
 			// 비밀번호 변경
 			me.PUT("/password", controllers.ChangePassword)
 		}
 
+		// 언론사 목록
+		v1.GET("/publishers", controllers.GetPublishers)
+
+		// 태그별 뉴스 (뉴스 상세의 tags[].tag)
+		tags := v1.Group("/tags")
+		{
+			tags.GET("/:tag/news", middlewares.AuthMiddlewareOptional(), controllers.GetNewsByTag)
+		}
+
+		// 지금 뜨는 이슈
+		v1.GET("/trends", middlewares.AuthMiddlewareOptional(), controllers.GetTrends)
+
+		onboarding := v1.Group("/onboarding")
+		{
+			onboarding.GET("/seeds", controllers.GetOnboardingSeeds)
+		}
+
 		community := v1.Group("/community")
 		{
 			community.GET("/posts", controllers.GetCommunityPosts)
 			community.POST("/posts", middlewares.AuthMiddleware(), controllers.CreatePost)
-			community.GET("/posts/:postId/comments", setTarget("post"), controllers.GetComments)
+			community.GET("/posts/:postId", middlewares.AuthMiddlewareOptional(), controllers.GetPostDetail)
+			community.PATCH("/posts/:postId", middlewares.AuthMiddleware(), controllers.UpdateMyPost)
+			community.GET("/posts/:postId/history", middlewares.AuthMiddleware(), controllers.GetPostEditHistory)
+			community.GET("/posts/:postId/comments", middlewares.AuthMiddlewareOptional(), setTarget("post"), controllers.GetComments)
 			community.POST("/posts/:postId/comments", middlewares.AuthMiddleware(), setTarget("post"), controllers.CreateComment)
+			community.GET("/posts/:postId/comments/:commentId/replies", middlewares.AuthMiddlewareOptional(), setTarget("post"), controllers.GetCommentReplies)
+			community.PATCH("/posts/:postId/comments/:commentId", middlewares.AuthMiddleware(), setTarget("post"), controllers.UpdateComment)
+			community.DELETE("/posts/:postId/comments/:commentId", middlewares.AuthMiddleware(), setTarget("post"), controllers.DeleteComment)
+			community.POST("/posts/:postId/comments/:commentId/interact", middlewares.AuthMiddleware(), setTarget("post"), controllers.InteractComment)
 			// 게시글 상호작용
 			community.POST("/posts/:postId/interact", middlewares.AuthMiddleware(), controllers.InteractPost)
 			community.DELETE("/posts/:postId", middlewares.AuthMiddleware(), controllers.DeleteMyPost)
 		}
+
+		// 관리자 전용
+		admin := v1.Group("/admin", middlewares.AuthMiddleware(), middlewares.AdminMiddleware())
+		{
+			admin.GET("/recommendations/debug", controllers.GetRecommendationDebug)
+			admin.GET("/experiments", controllers.GetExperiments)
+			admin.GET("/experiments/:experimentKey/report", controllers.GetExperimentReport)
+			admin.GET("/ingestion/runs", controllers.GetIngestionRuns)
+			admin.POST("/ingestion/runs", controllers.TriggerIngestionRun)
+			admin.POST("/retention/run", controllers.RunNewsRetention)
+		}
 	}
 
 	return router
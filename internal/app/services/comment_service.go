@@ -2,9 +2,13 @@ package services
 
 import (
 	"errors"
+	"fmt"
+	"newsclip/backend/config"
 	"newsclip/backend/internal/app/models"
 	"newsclip/backend/internal/app/repositories"
 	"time"
+
+	"gorm.io/gorm"
 )
 
 // === [DTO] API 응답용 구조체 ===
@@ -16,70 +20,339 @@ type UserSummaryDTO struct {
 }
 
 type CommentResponseDTO struct {
-	CommentID uint           `json:"comment_id"`
-	Content   string         `json:"content"`
-	CreatedAt time.Time      `json:"created_at"`
-	User      UserSummaryDTO `json:"user"`
+	CommentID  uint           `json:"comment_id"`
+	ParentID   *uint          `json:"parent_id"` // 최상위 댓글이면 null
+	Content    string         `json:"content"`
+	CreatedAt  time.Time      `json:"created_at"`
+	EditedAt   *time.Time     `json:"edited_at"` // 수정된 적 없으면 null
+	IsDeleted  bool           `json:"is_deleted"`
+	ReplyCount int            `json:"reply_count"`
+	User       UserSummaryDTO `json:"user"`
+
+	// === [신규] 좋아요/싫어요 ===
+	LikeCount    int  `json:"like_count"`
+	DislikeCount int  `json:"dislike_count"`
+	IsLiked      bool `json:"is_liked"`
+	IsDisliked   bool `json:"is_disliked"`
+}
+
+// 댓글 목록 응답 (커서 페이징)
+type CommentListResponseDTO struct {
+	Comments   []CommentResponseDTO `json:"comments"`
+	NextCursor *uint                `json:"next_cursor"` // 다음 페이지 요청 시 cursorId로 사용 (없으면 null)
+	HasNext    bool                 `json:"has_next"`
 }
 
+// 삭제된 댓글 자리표시 문구
+const deletedCommentPlaceholder = "삭제된 댓글입니다"
+
 // === 댓글 작성 서비스 ===
-func CreateComment(targetType string, targetID, userID uint, content string) (uint, error) {
-	switch targetType {
-	case "news":
-		comment := models.NewsComment{NewsID: targetID, UserID: userID, Content: content}
-		err := repositories.CreateNewsComment(&comment)
-		return comment.ID, err
-	case "short":
-		comment := models.ShortComment{ShortID: targetID, UserID: userID, Content: content}
-		err := repositories.CreateShortComment(&comment)
-		return comment.ID, err
-	case "post":
-		comment := models.PostComment{PostID: targetID, UserID: userID, Content: content}
-		err := repositories.CreatePostComment(&comment)
-		return comment.ID, err
-	default:
-		return 0, errors.New("잘못된 대상 타입입니다")
+// parentID가 nil이 아니면 해당 댓글의 답글로 작성 (1단계까지만 허용)
+func CreateComment(targetType string, targetID, userID uint, content string, parentID *uint) (uint, error) {
+
+	// 1. 답글이면 부모 댓글 검증
+	if parentID != nil {
+		parent, err := repositories.FindCommentByID(*parentID)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return 0, errors.New("부모 댓글을 찾을 수 없습니다")
+			}
+			return 0, err
+		}
+		if parent.TargetType != targetType || parent.TargetID != targetID || parent.IsDeleted {
+			return 0, errors.New("부모 댓글을 찾을 수 없습니다")
+		}
+		if parent.ParentID != nil {
+			return 0, errors.New("답글에는 답글을 달 수 없습니다")
+		}
 	}
+
+	// 2. 댓글 생성 (대상 타입 검증은 레포지토리에서 처리)
+	comment := models.Comment{
+		TargetType: targetType,
+		TargetID:   targetID,
+		UserID:     userID,
+		Content:    content,
+		ParentID:   parentID,
+	}
+	err := repositories.CreateComment(&comment)
+	return comment.ID, err
 }
 
-// === 댓글 목록 조회 서비스 ===
-func GetComments(targetType string, targetID uint) ([]CommentResponseDTO, error) {
-	var dtos []CommentResponseDTO
+// === 댓글 목록 조회 서비스 (최상위 댓글) ===
+// sortBy: latest(기본) / oldest / best, userID: 0이면 비로그인
+func GetComments(targetType string, targetID uint, sortBy string, cursorID uint, size int, userID uint) (*CommentListResponseDTO, error) {
+	if sortBy != repositories.CommentSortOldest && sortBy != repositories.CommentSortBest {
+		sortBy = repositories.CommentSortLatest
+	}
 
-	switch targetType {
-	case "news":
-		comments, err := repositories.GetNewsComments(targetID)
-		if err != nil {
-			return nil, err
+	// 다음 페이지 존재 여부 확인을 위해 1개 더 조회
+	rows, err := repositories.GetTopLevelComments(targetType, targetID, sortBy, cursorID, size+1)
+	if err != nil {
+		return nil, err
+	}
+
+	return buildCommentList(targetType, rows, size, userID)
+}
+
+// === 답글 목록 조회 서비스 (작성순) ===
+func GetReplies(targetType string, targetID, parentID uint, cursorID uint, size int, userID uint) (*CommentListResponseDTO, error) {
+	parent, err := repositories.FindCommentByID(parentID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("댓글을 찾을 수 없습니다")
+		}
+		return nil, err
+	}
+	if parent.TargetType != targetType || parent.TargetID != targetID {
+		return nil, errors.New("댓글을 찾을 수 없습니다")
+	}
+
+	rows, err := repositories.GetCommentReplies(parentID, cursorID, size+1)
+	if err != nil {
+		return nil, err
+	}
+
+	return buildCommentList(targetType, rows, size, userID)
+}
+
+// === 댓글 수정 서비스 ===
+func UpdateComment(targetType string, targetID, commentID, userID uint, content string) (*CommentResponseDTO, error) {
+	comment, err := findOwnComment(targetType, targetID, commentID, userID, "수정")
+	if err != nil {
+		return nil, err
+	}
+
+	if err := repositories.UpdateCommentContent(&comment, content); err != nil {
+		return nil, err
+	}
+
+	updated, err := repositories.FindCommentByID(comment.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := repositories.FindUserByID(userID)
+	if err != nil {
+		return nil, err
+	}
+	updated.User = user
+
+	dto := convertToDTO(updated)
+
+	// 본인의 좋아요/싫어요 상태 반영
+	interactions, err := repositories.FindCommentInteractionsByIDs(userID, targetType, []uint{comment.ID})
+	if err == nil && len(interactions) > 0 {
+		dto.IsLiked = interactions[0].InteractionType == "like"
+		dto.IsDisliked = interactions[0].InteractionType == "dislike"
+	}
+
+	return &dto, nil
+}
+
+// === 댓글 삭제 서비스 ===
+func DeleteComment(targetType string, targetID, commentID, userID uint) error {
+	comment, err := findOwnComment(targetType, targetID, commentID, userID, "삭제")
+	if err != nil {
+		return err
+	}
+
+	return repositories.SoftDeleteComment(&comment)
+}
+
+// === 댓글 상호작용 서비스 ===
+// (뉴스 상호작용과 동일: 최초 클릭 생성 / 같은 버튼 취소 / 다른 버튼 전환)
+func InteractWithComment(targetType string, targetID, commentID, userID uint, newType string) (*InteractionResponseDTO, error) {
+
+	// 0. 댓글 검증 (대상 일치, 삭제되지 않음)
+	comment, err := repositories.FindCommentByID(commentID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("댓글을 찾을 수 없습니다")
 		}
-		for _, c := range comments {
-			dtos = append(dtos, convertToDTO(c.ID, c.Content, c.CreatedAt, c.User))
+		return nil, err
+	}
+	if comment.TargetType != targetType || comment.TargetID != targetID || comment.IsDeleted {
+		return nil, errors.New("댓글을 찾을 수 없습니다")
+	}
+
+	var finalResponse InteractionResponseDTO
+
+	// 트랜잭션 시작
+	err = config.DB.Transaction(func(tx *gorm.DB) error {
+
+		// 1. 기존 상호작용 조회
+		existingInteraction, err := repositories.FindCommentInteraction(tx, userID, targetType, commentID)
+
+		var likeDelta, dislikeDelta int = 0, 0
+
+		// [시나리오 1] 최초 상호작용
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			newInteraction := &models.CommentInteraction{
+				UserID:          userID,
+				TargetType:      targetType,
+				CommentID:       commentID,
+				InteractionType: newType,
+			}
+			if err := repositories.CreateCommentInteraction(tx, newInteraction); err != nil {
+				return err
+			}
+
+			if newType == "like" {
+				likeDelta = 1
+			} else {
+				dislikeDelta = 1
+			}
+
+			finalResponse.IsLiked = (newType == "like")
+			finalResponse.IsDisliked = (newType == "dislike")
+
+			// [시나리오 2] 이미 존재함
+		} else if err == nil {
+			// [2-A] 취소 (같은 타입 클릭)
+			if existingInteraction.InteractionType == newType {
+				if err := repositories.DeleteCommentInteraction(tx, &existingInteraction); err != nil {
+					return err
+				}
+				if newType == "like" {
+					likeDelta = -1
+				} else {
+					dislikeDelta = -1
+				}
+
+				finalResponse.IsLiked = false
+				finalResponse.IsDisliked = false
+			} else {
+				// [2-B] 전환 (다른 타입 클릭)
+				if err := repositories.UpdateCommentInteraction(tx, &existingInteraction, newType); err != nil {
+					return err
+				}
+				if newType == "like" { // dislike -> like
+					likeDelta = 1
+					dislikeDelta = -1
+				} else { // like -> dislike
+					likeDelta = -1
+					dislikeDelta = 1
+				}
+
+				finalResponse.IsLiked = (newType == "like")
+				finalResponse.IsDisliked = (newType == "dislike")
+			}
+		} else {
+			return err // DB 에러
 		}
-	case "short":
-		comments, err := repositories.GetShortComments(targetID)
-		if err != nil {
-			return nil, err
+
+		// 2. 카운트 업데이트
+		if err := repositories.UpdateCommentCounts(tx, commentID, likeDelta, dislikeDelta); err != nil {
+			return err
 		}
-		for _, c := range comments {
-			dtos = append(dtos, convertToDTO(c.ID, c.Content, c.CreatedAt, c.User))
+
+		// 3. 최신 카운트 조회
+		var updated models.Comment
+		if err := tx.Select("like_count", "dislike_count").First(&updated, commentID).Error; err != nil {
+			return err
 		}
-	case "post":
-		comments, err := repositories.GetPostComments(targetID)
-		if err != nil {
-			return nil, err
+
+		finalResponse.LikeCount = updated.LikeCount
+		finalResponse.DislikeCount = updated.DislikeCount
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &finalResponse, nil
+}
+
+// (헬퍼 함수) 본인이 작성한, 삭제되지 않은 댓글인지 확인
+func findOwnComment(targetType string, targetID, commentID, userID uint, action string) (models.Comment, error) {
+	comment, err := repositories.FindCommentByID(commentID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return comment, errors.New("댓글을 찾을 수 없습니다")
 		}
-		for _, c := range comments {
-			dtos = append(dtos, convertToDTO(c.ID, c.Content, c.CreatedAt, c.User))
+		return comment, err
+	}
+
+	if comment.TargetType != targetType || comment.TargetID != targetID || comment.IsDeleted {
+		return comment, errors.New("댓글을 찾을 수 없습니다")
+	}
+
+	if comment.UserID != userID {
+		return comment, fmt.Errorf("본인이 작성한 댓글만 %s할 수 있습니다", action)
+	}
+
+	return comment, nil
+}
+
+// (헬퍼 함수) 조회 결과를 페이지 응답으로 변환 (rows는 size+1개까지 조회된 상태)
+func buildCommentList(targetType string, rows []models.Comment, size int, userID uint) (*CommentListResponseDTO, error) {
+	response := &CommentListResponseDTO{Comments: []CommentResponseDTO{}}
+
+	if len(rows) > size {
+		rows = rows[:size]
+		response.HasNext = true
+	}
+	if len(rows) == 0 {
+		return response, nil
+	}
+
+	// (로그인 유저라면) 좋아요/싫어요 상태 일괄 조회
+	commentIDs := make([]uint, len(rows))
+	for i, r := range rows {
+		commentIDs[i] = r.ID
+	}
+	interactionMap := make(map[uint]string)
+	if userID != 0 {
+		interactions, err := repositories.FindCommentInteractionsByIDs(userID, targetType, commentIDs)
+		if err == nil {
+			for _, inter := range interactions {
+				interactionMap[inter.CommentID] = inter.InteractionType
+			}
 		}
-	default:
-		return nil, errors.New("잘못된 대상 타입입니다")
 	}
 
-	return dtos, nil
+	for _, r := range rows {
+		dto := convertToDTO(r)
+		dto.IsLiked = interactionMap[r.ID] == "like"
+		dto.IsDisliked = interactionMap[r.ID] == "dislike"
+		response.Comments = append(response.Comments, dto)
+	}
+
+	if response.HasNext {
+		lastID := rows[len(rows)-1].ID
+		response.NextCursor = &lastID
+	}
+
+	return response, nil
 }
 
-// (헬퍼 함수) 모델 데이터를 DTO로 변환
-func convertToDTO(id uint, content string, createdAt time.Time, user models.User) CommentResponseDTO {
+// (헬퍼 함수) 모델 데이터를 DTO로 변환 (User가 Preload 되어 있어야 함)
+// (삭제된 댓글은 내용과 작성자를 가리고 자리표시 문구로 대체)
+func convertToDTO(row models.Comment) CommentResponseDTO {
+	dto := CommentResponseDTO{
+		CommentID:  row.ID,
+		ParentID:   row.ParentID,
+		Content:    row.Content,
+		CreatedAt:  row.CreatedAt,
+		EditedAt:   row.EditedAt,
+		IsDeleted:  row.IsDeleted,
+		ReplyCount: row.ReplyCount,
+
+		LikeCount:    row.LikeCount,
+		DislikeCount: row.DislikeCount,
+	}
+
+	if row.IsDeleted {
+		dto.Content = deletedCommentPlaceholder
+		dto.EditedAt = nil
+		return dto
+	}
+
+	user := row.User
+
 	// User 모델의 Nickname 등이 포인터일 경우 안전하게 처리
 	nickname := ""
 	if user.Nickname != nil {
@@ -91,17 +364,14 @@ func convertToDTO(id uint, content string, createdAt time.Time, user models.User
 		profileImage = *user.ProfileImage
 	}
 
-	return CommentResponseDTO{
-		CommentID: id,
-		Content:   content,
-		CreatedAt: createdAt,
-		User: UserSummaryDTO{
-			ID:           user.ID,
-			Nickname:     nickname,
-			ProfileImage: profileImage,
-			Role:         user.Role,
-		},
+	dto.User = UserSummaryDTO{
+		ID:           user.ID,
+		Nickname:     nickname,
+		ProfileImage: profileImage,
+		Role:         user.Role,
 	}
+
+	return dto
 }
 
 // === [7.8] 내가 쓴 댓글 목록 DTO ===
@@ -129,11 +399,11 @@ func GetMyComments(userID uint, page, size int) (*MyCommentListResponseDTO, erro
 
 	for i, row := range rows {
 		result[i] = MyCommentItemDTO{
-			CommentID:  uint(row["id"].(int64)),
-			Content:    row["content"].(string),
-			TargetType: row["target_type"].(string),
-			TargetID:   uint(row["target_id"].(int64)),
-			CreatedAt:  row["created_at"].(time.Time).Format(time.RFC3339),
+			CommentID:  row.ID,
+			Content:    row.Content,
+			TargetType: row.TargetType,
+			TargetID:   row.TargetID,
+			CreatedAt:  row.CreatedAt.Format(time.RFC3339),
 		}
 	}
 
@@ -0,0 +1,234 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"log"
+	"newsclip/backend/config"
+	"newsclip/backend/internal/app/models"
+	"newsclip/backend/internal/app/repositories"
+	"newsclip/backend/pkg/extractor"
+	"newsclip/backend/pkg/fetcher"
+	"sync"
+	"time"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+// 본문 추출 규칙 설정 파일 기본 경로 (EXTRACTORS_FILE 로 변경 가능)
+const defaultExtractorsFile = "config/extractors.json"
+
+var (
+	extractorRegistryOnce sync.Once
+	extractorRegistry     *extractor.Registry
+)
+
+// 본문 추출기 레지스트리 로드 (최초 1회, 파일이 없으면 기본 규칙 사용)
+func loadExtractorRegistry() *extractor.Registry {
+	extractorRegistryOnce.Do(func() {
+		path := config.GetEnv("EXTRACTORS_FILE")
+		if path == "" {
+			path = defaultExtractorsFile
+		}
+
+		registry, err := extractor.LoadRegistry(path)
+		if err != nil {
+			log.Printf("⚠️ [Extractor] Failed to load %s, using default rules: %v", path, err)
+			registry = extractor.NewRegistry(extractor.DefaultRules)
+		}
+		extractorRegistry = registry
+		log.Printf("📰 [Extractor] Loaded %d site rules", registry.Len())
+	})
+	return extractorRegistry
+}
+
+// 페이지 1건 수집에 허용하는 전체 시간 (호스트 대기/재시도 포함)
+const scrapeTotalTimeout = 60 * time.Second
+
+var (
+	scraperOnce sync.Once
+	scraper     *fetcher.Fetcher
+)
+
+// === 크롤링/메타데이터 수집용 공용 HTTP 수집기 (최초 1회 생성) ===
+// 호스트별 동시성/간격 제한, robots.txt, 재시도, EUC-KR 변환, 조건부 요청 처리
+func getScraper() *fetcher.Fetcher {
+	scraperOnce.Do(func() {
+		opts := fetcher.DefaultOptions()
+		if ua := config.GetEnv("SCRAPER_USER_AGENT"); ua != "" {
+			opts.UserAgent = ua
+		}
+		opts.Timeout = time.Duration(config.GetEnvFloat("SCRAPER_TIMEOUT_SECONDS", opts.Timeout.Seconds()) * float64(time.Second))
+		opts.MaxBodyBytes = int64(config.GetEnvFloat("SCRAPER_MAX_BODY_MB", float64(opts.MaxBodyBytes>>20)) * (1 << 20))
+		opts.PerHostConcurrency = int(config.GetEnvFloat("SCRAPER_HOST_CONCURRENCY", float64(opts.PerHostConcurrency)))
+		opts.PerHostInterval = time.Duration(config.GetEnvFloat("SCRAPER_HOST_INTERVAL_MS", float64(opts.PerHostInterval.Milliseconds())) * float64(time.Millisecond))
+		opts.MaxRetries = int(config.GetEnvFloat("SCRAPER_MAX_RETRIES", float64(opts.MaxRetries)))
+		opts.RespectRobots = config.GetEnv("SCRAPER_IGNORE_ROBOTS") != "true"
+		scraper = fetcher.New(opts)
+	})
+	return scraper
+}
+
+// (헬퍼 함수) 페이지를 수집해 HTML 문서로 파싱 (최종 URL 함께 반환)
+func fetchDocument(pageURL string) (*goquery.Document, string, error) {
+	doc, res, err := fetchDocumentConditional(pageURL, fetcher.Validators{})
+	if err != nil {
+		return nil, "", err
+	}
+	return doc, res.URL.String(), nil
+}
+
+// (헬퍼 함수) 검증값으로 조건부 수집 후 HTML 문서로 파싱 (304 이면 문서 없이 응답만 반환)
+func fetchDocumentConditional(pageURL string, validators fetcher.Validators) (*goquery.Document, *fetcher.Response, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), scrapeTotalTimeout)
+	defer cancel()
+
+	res, err := getScraper().GetConditional(ctx, pageURL, validators)
+	if err != nil {
+		return nil, nil, err
+	}
+	if res.NotModified {
+		return nil, res, nil
+	}
+
+	doc, err := goquery.NewDocumentFromReader(bytes.NewReader(res.Body))
+	if err != nil {
+		return nil, nil, err
+	}
+	return doc, res, nil
+}
+
+// 본문 크롤링 결과 1건
+type crawledPage struct {
+	extractor.Result
+	URL         string             // 요청한 URL (다음 조건부 요청 기준)
+	Validators  fetcher.Validators // 응답의 ETag / Last-Modified
+	NotModified bool               // 304: 저장해 둔 본문이 그대로 유효
+}
+
+// === [내부 함수] 뉴스 본문 크롤링 ===
+// [수정] 언론사 원문(OriginalURL)을 먼저 시도하고, 실패하거나 본문이 없으면 네이버 링크로 재시도
+// (네이버 뉴스는 robots.txt 로 일반 봇 수집을 막을 수 있으므로 대체용으로만 사용)
+// [수정] 저장된 본문을 가져온 URL 에는 ETag / Last-Modified 로 조건부 요청 (304 면 NotModified)
+func crawlNewsContent(news *models.News) (crawledPage, error) {
+	var lastErr error
+	var empty *crawledPage
+	for _, pageURL := range newsContentURLs(news) {
+		page, err := crawlPage(pageURL, storedValidators(news, pageURL))
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		if page.NotModified || page.Text != "" {
+			return page, nil
+		}
+		empty = &page
+	}
+
+	// 페이지는 받았지만 본문을 못 찾은 경우 빈 본문으로 기록 (다시 크롤링하지 않음)
+	if empty != nil {
+		return *empty, nil
+	}
+	return crawledPage{}, lastErr
+}
+
+// (헬퍼 함수) pageURL 에 보낼 조건부 요청 검증값
+// 저장된 본문이 같은 URL 에서 온 경우만 사용 (다른 URL 의 Last-Modified 로 304 를 받으면 엉뚱한 본문이 남음)
+func storedValidators(news *models.News, pageURL string) fetcher.Validators {
+	if news.FullContent == "" || news.ContentURL != pageURL {
+		return fetcher.Validators{}
+	}
+	return fetcher.Validators{ETag: news.ContentETag, LastModified: news.ContentLastModified}
+}
+
+// (헬퍼 함수) 본문 크롤링 후보 URL (언론사 원문 → 네이버 링크, 중복 제외)
+func newsContentURLs(news *models.News) []string {
+	var urls []string
+	for _, u := range []string{news.OriginalURL, news.URL} {
+		if u != "" && (len(urls) == 0 || urls[0] != u) {
+			urls = append(urls, u)
+		}
+	}
+	return urls
+}
+
+// (내부 함수) 페이지 1건 본문 추출
+// 호스트별 규칙으로 추출하고, 규칙이 없는 언론사는 텍스트 밀도 기반 범용 추출기 사용
+func crawlPage(pageURL string, validators fetcher.Validators) (crawledPage, error) {
+	doc, res, err := fetchDocumentConditional(pageURL, validators)
+	if err != nil {
+		return crawledPage{}, err
+	}
+
+	page := crawledPage{URL: pageURL, Validators: res.Validators, NotModified: res.NotModified}
+	if res.NotModified {
+		return page, nil
+	}
+
+	// 리다이렉트된 경우 최종 URL 기준으로 규칙 선택
+	page.Result = loadExtractorRegistry().Extract(res.URL.String(), doc)
+	return page, nil
+}
+
+// === 뉴스 원문 본문 조회 (저장된 본문 우선, 없으면 1회 크롤링 후 저장) ===
+// 쇼츠 요약, 검색, 읽기 시간 계산 등에서 재사용
+func GetNewsFullContent(news *models.News) (string, error) {
+	// 1. 이미 크롤링한 기사면 저장된 본문 반환 (실패했던 기사는 빈 문자열)
+	if news.ContentFetchedAt != nil {
+		return news.FullContent, nil
+	}
+
+	// 2. 크롤링 + 저장
+	if err := RecrawlNewsContent(news); err != nil {
+		return "", err
+	}
+	return news.FullContent, nil
+}
+
+// === [신규] 뉴스 원문 본문 (재)크롤링 후 저장 ===
+// 본문이 바뀌면 읽기 시간도 다시 계산
+// (다시 크롤링했는데 본문을 못 찾으면 기존 본문 유지: 기사 삭제/차단 등)
+// [수정] 이전 응답의 ETag / Last-Modified 로 조건부 요청, 304 면 저장된 본문 유지
+func RecrawlNewsContent(news *models.News) error {
+	// 1. 크롤링
+	page, err := crawlNewsContent(news)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+
+	// 2. 원문이 바뀌지 않음 (304): 크롤링 시각/검증값만 갱신
+	if page.NotModified {
+		if err := repositories.TouchNewsContentFetched(news.ID, now, page.Validators.ETag, page.Validators.LastModified); err != nil {
+			log.Printf("⚠️ [Extractor] Failed to save fetch time for NewsID %d: %v", news.ID, err)
+		}
+		news.ContentFetchedAt = &now
+		news.ContentETag = page.Validators.ETag
+		news.ContentLastModified = page.Validators.LastModified
+		return nil
+	}
+
+	if page.Text == "" && news.FullContent != "" {
+		log.Printf("⚠️ [Extractor] No content found on recrawl for NewsID %d, keeping previous body", news.ID)
+		return nil
+	}
+
+	// 3. 저장 (실패해도 본문은 반환)
+	readTime := estimateReadTime(page.Text)
+	err = repositories.UpdateNewsFullContent(news.ID, page.Text, page.Extractor, readTime, now,
+		page.URL, page.Validators.ETag, page.Validators.LastModified)
+	if err != nil {
+		log.Printf("⚠️ [Extractor] Failed to save full content for NewsID %d: %v", news.ID, err)
+	}
+	news.FullContent = page.Text
+	news.ContentExtractor = page.Extractor
+	news.ContentFetchedAt = &now
+	news.ContentURL = page.URL
+	news.ContentETag = page.Validators.ETag
+	news.ContentLastModified = page.Validators.LastModified
+	if readTime > 0 {
+		news.ReadTimeMinutes = readTime
+	}
+	return nil
+}
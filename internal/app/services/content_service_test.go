@@ -0,0 +1,184 @@
+package services
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"newsclip/backend/internal/app/models"
+	"newsclip/backend/pkg/fetcher"
+	"strings"
+	"testing"
+	"time"
+)
+
+// 테스트용 수집기 (재시도/호스트 간격 없이)
+func useTestScraper(t *testing.T) {
+	t.Helper()
+	opts := fetcher.DefaultOptions()
+	opts.PerHostInterval = 0
+	opts.MaxRetries = 0
+	opts.Timeout = 5 * time.Second
+	scraperOnce.Do(func() {})
+	scraper = fetcher.New(opts)
+}
+
+// 기사 페이지 + robots.txt 를 제공하는 스텁 서버
+func newArticleServer(t *testing.T, robots string, body string) *httptest.Server {
+	t.Helper()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/robots.txt":
+			w.Write([]byte(robots))
+		case "/article":
+			w.Header().Set("Content-Type", "text/html; charset=utf-8")
+			w.Write([]byte(`<html><body><article id="article-body">` + body + `</article></body></html>`))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func articleHTML(sentence string) string {
+	var b strings.Builder
+	for i := 0; i < 5; i++ {
+		b.WriteString("<p>" + strings.Repeat(sentence+" ", 10) + "</p>")
+	}
+	return b.String()
+}
+
+func TestCrawlNewsContentPrefersOriginalURL(t *testing.T) {
+	useTestScraper(t)
+
+	naver := newArticleServer(t, "User-agent: *\nDisallow: /\n", articleHTML("네이버 본문입니다."))
+	publisher := newArticleServer(t, "User-agent: *\nAllow: /\n", articleHTML("언론사 원문 본문입니다."))
+
+	tests := []struct {
+		name     string
+		news     models.News
+		wantText string
+		wantErr  error
+	}{
+		{
+			name:     "publisher page is crawled even when naver disallows bots",
+			news:     models.News{URL: naver.URL + "/article", OriginalURL: publisher.URL + "/article"},
+			wantText: "언론사 원문 본문입니다.",
+		},
+		{
+			name:     "falls back to naver link when publisher page fails",
+			news:     models.News{URL: publisher.URL + "/article", OriginalURL: publisher.URL + "/missing"},
+			wantText: "언론사 원문 본문입니다.",
+		},
+		{
+			name:    "robots error is returned when every candidate is disallowed",
+			news:    models.News{URL: naver.URL + "/article", OriginalURL: ""},
+			wantErr: fetcher.ErrDisallowedByRobots,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			page, err := crawlNewsContent(&tt.news)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("err = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !strings.Contains(page.Text, tt.wantText) {
+				t.Errorf("text = %q, want it to contain %q", page.Text, tt.wantText)
+			}
+		})
+	}
+}
+
+func TestCrawlNewsContentConditional(t *testing.T) {
+	useTestScraper(t)
+
+	// ETag "v1" 이 오면 304, 아니면 본문 + ETag
+	var ifNoneMatch []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/robots.txt" {
+			http.NotFound(w, r)
+			return
+		}
+		ifNoneMatch = append(ifNoneMatch, r.URL.Path+"="+r.Header.Get("If-None-Match"))
+		if r.Header.Get("If-None-Match") == `"v1"` {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", `"v1"`)
+		w.Write([]byte(`<html><body><article>` + articleHTML("언론사 원문 본문입니다.") + `</article></body></html>`))
+	}))
+	defer srv.Close()
+
+	tests := []struct {
+		name            string
+		news            models.News
+		wantNotModified bool
+		wantRequest     string
+	}{
+		{
+			name:        "first crawl sends no validators",
+			news:        models.News{OriginalURL: srv.URL + "/a"},
+			wantRequest: "/a=",
+		},
+		{
+			name:            "stored validators are sent to the same URL and 304 keeps the body",
+			news:            models.News{OriginalURL: srv.URL + "/a", FullContent: "저장된 본문", ContentURL: srv.URL + "/a", ContentETag: `"v1"`},
+			wantNotModified: true,
+			wantRequest:     `/a="v1"`,
+		},
+		{
+			name:        "validators from another URL are not reused",
+			news:        models.News{OriginalURL: srv.URL + "/a", FullContent: "저장된 본문", ContentURL: srv.URL + "/b", ContentETag: `"v1"`},
+			wantRequest: "/a=",
+		},
+		{
+			name:        "validators are not sent when no body was stored",
+			news:        models.News{OriginalURL: srv.URL + "/a", ContentURL: srv.URL + "/a", ContentETag: `"v1"`},
+			wantRequest: "/a=",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ifNoneMatch = nil
+			page, err := crawlNewsContent(&tt.news)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if page.NotModified != tt.wantNotModified {
+				t.Errorf("NotModified = %v, want %v", page.NotModified, tt.wantNotModified)
+			}
+			if !page.NotModified && (page.Text == "" || page.Validators.ETag != `"v1"` || page.URL != srv.URL+"/a") {
+				t.Errorf("page = %+v, want body with ETag and URL", page)
+			}
+			if len(ifNoneMatch) != 1 || ifNoneMatch[0] != tt.wantRequest {
+				t.Errorf("requests = %v, want [%s]", ifNoneMatch, tt.wantRequest)
+			}
+		})
+	}
+}
+
+func TestNewsContentURLs(t *testing.T) {
+	tests := []struct {
+		news models.News
+		want []string
+	}{
+		{models.News{URL: "n", OriginalURL: "o"}, []string{"o", "n"}},
+		{models.News{URL: "n"}, []string{"n"}},
+		{models.News{URL: "same", OriginalURL: "same"}, []string{"same"}},
+		{models.News{}, nil},
+	}
+	for _, tt := range tests {
+		got := newsContentURLs(&tt.news)
+		if strings.Join(got, ",") != strings.Join(tt.want, ",") {
+			t.Errorf("newsContentURLs(%+v) = %v, want %v", tt.news, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,261 @@
+package services
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"log"
+	"newsclip/backend/config"
+	"newsclip/backend/internal/app/models"
+	"newsclip/backend/internal/app/repositories"
+	"os"
+	"sort"
+	"sync"
+	"time"
+)
+
+// 서비스에서 사용하는 실험 키
+const (
+	ExperimentRecommendationRanker = "reco_ranker"   // 추천 팝업(GetRecommendedNews) 점수 공식
+	ExperimentShortsRanker         = "shorts_ranker" // 맞춤 쇼츠 피드(for_you) 점수 공식
+)
+
+// 실험 설정 파일 기본 경로 (EXPERIMENTS_FILE 로 변경 가능)
+const defaultExperimentsFile = "config/experiments.json"
+
+// 실험 할당 버킷 수 (비율 계산 정밀도)
+const experimentBuckets = 10000
+
+// === 실험 설정 ===
+type Experiment struct {
+	Key      string              `json:"key"`
+	Enabled  bool                `json:"enabled"`
+	Variants []ExperimentVariant `json:"variants"` // 첫 번째 변형을 대조군(control)으로 간주
+}
+
+type ExperimentVariant struct {
+	Name   string             `json:"name"`
+	Weight int                `json:"weight"` // 할당 비율 (변형들 간 상대값)
+	Params map[string]float64 `json:"params"` // 가중치 덮어쓰기 (예: {"RECO_MMR_LAMBDA": 1.0})
+}
+
+var (
+	experimentsOnce sync.Once
+	experiments     map[string]Experiment
+)
+
+// 실험 설정 로드 (최초 1회, 파일이 없으면 실험 없이 동작)
+func loadExperiments() map[string]Experiment {
+	experimentsOnce.Do(func() {
+		experiments = map[string]Experiment{}
+
+		path := config.GetEnv("EXPERIMENTS_FILE")
+		if path == "" {
+			path = defaultExperimentsFile
+		}
+
+		data, err := os.ReadFile(path)
+		if err != nil {
+			log.Printf("🧪 [Experiment] No experiments loaded (%v)", err)
+			return
+		}
+
+		var list []Experiment
+		if err := json.Unmarshal(data, &list); err != nil {
+			log.Printf("🔥 [Experiment] Invalid experiments file %s: %v", path, err)
+			return
+		}
+
+		for _, exp := range list {
+			if err := validateExperiment(exp); err != nil {
+				log.Printf("🔥 [Experiment] Skipping '%s': %v", exp.Key, err)
+				continue
+			}
+			experiments[exp.Key] = exp
+		}
+		log.Printf("🧪 [Experiment] Loaded %d experiments from %s", len(experiments), path)
+	})
+	return experiments
+}
+
+// (헬퍼 함수) 실험 설정 검증
+func validateExperiment(exp Experiment) error {
+	if exp.Key == "" {
+		return errors.New("key is empty")
+	}
+	if len(exp.Variants) == 0 {
+		return errors.New("no variants")
+	}
+
+	total := 0
+	names := make(map[string]bool, len(exp.Variants))
+	for _, v := range exp.Variants {
+		if v.Name == "" || names[v.Name] {
+			return fmt.Errorf("invalid or duplicate variant name '%s'", v.Name)
+		}
+		if v.Weight < 0 {
+			return fmt.Errorf("negative weight for variant '%s'", v.Name)
+		}
+		names[v.Name] = true
+		total += v.Weight
+	}
+	if total == 0 {
+		return errors.New("total weight is zero")
+	}
+	return nil
+}
+
+// === 사용자 실험 변형 할당 ===
+// 같은 사용자는 같은 실험에서 항상 같은 변형을 받음 (실험 키 + 사용자 ID 해시 기반)
+// 비로그인 사용자이거나 실험이 없거나 꺼져 있으면 nil (기본 동작 사용)
+func AssignExperimentVariant(experimentKey string, userID uint) *ExperimentVariant {
+	if userID == 0 {
+		return nil
+	}
+
+	exp, ok := loadExperiments()[experimentKey]
+	if !ok || !exp.Enabled {
+		return nil
+	}
+
+	h := fnv.New32a()
+	fmt.Fprintf(h, "%s:%d", experimentKey, userID)
+	bucket := int(h.Sum32() % experimentBuckets)
+
+	total := 0
+	for _, v := range exp.Variants {
+		total += v.Weight
+	}
+
+	cumulative := 0
+	for i := range exp.Variants {
+		cumulative += exp.Variants[i].Weight
+		if bucket*total < cumulative*experimentBuckets {
+			return &exp.Variants[i]
+		}
+	}
+	return &exp.Variants[len(exp.Variants)-1]
+}
+
+// === 실험 노출 기록 (응답 속도에 영향이 없도록 백그라운드 저장) ===
+func LogExperimentExposures(experimentKey string, variant *ExperimentVariant, userID uint, itemType string, itemIDs []uint) {
+	if variant == nil || userID == 0 || len(itemIDs) == 0 {
+		return
+	}
+
+	exposures := make([]models.ExperimentExposure, len(itemIDs))
+	for i, id := range itemIDs {
+		exposures[i] = models.ExperimentExposure{
+			ExperimentKey: experimentKey,
+			Variant:       variant.Name,
+			UserID:        userID,
+			ItemType:      itemType,
+			ItemID:        id,
+		}
+	}
+
+	go func() {
+		if err := repositories.CreateExperimentExposures(exposures); err != nil {
+			log.Printf("🔥 [Experiment] Failed to log exposures: %v", err)
+		}
+	}()
+}
+
+// === 노출된 아이템 클릭(상세 조회/시청) 기록 (백그라운드) ===
+func MarkExperimentClicks(userID uint, itemType string, itemIDs []uint) {
+	if userID == 0 || len(itemIDs) == 0 || len(loadExperiments()) == 0 {
+		return
+	}
+
+	go func() {
+		if err := repositories.MarkExperimentExposuresClicked(userID, itemType, itemIDs); err != nil {
+			log.Printf("🔥 [Experiment] Failed to mark clicks: %v", err)
+		}
+	}()
+}
+
+// === [관리자] 실험 목록 조회 ===
+func GetExperiments() []Experiment {
+	list := make([]Experiment, 0, len(loadExperiments()))
+	for _, exp := range loadExperiments() {
+		list = append(list, exp)
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].Key < list[j].Key })
+	return list
+}
+
+// === [관리자] 실험 리포트 DTO ===
+type ExperimentVariantReportDTO struct {
+	Variant      string  `json:"variant"`
+	Users        int64   `json:"users"`
+	Exposures    int64   `json:"exposures"`
+	Clicks       int64   `json:"clicks"`
+	CTR          float64 `json:"ctr"` // 클릭 / 노출
+	Likes        int64   `json:"likes"`
+	LikeRate     float64 `json:"likeRate"` // 좋아요 / 노출
+	Dislikes     int64   `json:"dislikes"`
+	DislikeRate  float64 `json:"dislikeRate"` // 싫어요 / 노출
+	Bookmarks    int64   `json:"bookmarks"`
+	BookmarkRate float64 `json:"bookmarkRate"` // 북마크 / 노출 (뉴스만 해당)
+}
+
+type ExperimentReportDTO struct {
+	Experiment Experiment                   `json:"experiment"`
+	Since      time.Time                    `json:"since"`
+	Variants   []ExperimentVariantReportDTO `json:"variants"`
+}
+
+// === [관리자] 실험 변형별 CTR/좋아요율 리포트 ===
+func GetExperimentReport(experimentKey string, days int) (*ExperimentReportDTO, error) {
+	exp, ok := loadExperiments()[experimentKey]
+	if !ok {
+		return nil, errors.New("실험을 찾을 수 없습니다")
+	}
+	if days <= 0 {
+		days = 14
+	}
+	since := time.Now().AddDate(0, 0, -days)
+
+	stats, err := repositories.GetExperimentVariantStats(experimentKey, since)
+	if err != nil {
+		return nil, err
+	}
+
+	rate := func(n, d int64) float64 {
+		if d == 0 {
+			return 0
+		}
+		return float64(n) / float64(d)
+	}
+
+	// 노출이 아직 없는 변형도 0으로 표시 (설정 순서 유지)
+	statsByVariant := make(map[string]repositories.ExperimentVariantStats, len(stats))
+	for _, st := range stats {
+		statsByVariant[st.Variant] = st
+	}
+
+	variants := make([]ExperimentVariantReportDTO, 0, len(exp.Variants))
+	for _, v := range exp.Variants {
+		st := statsByVariant[v.Name]
+		variants = append(variants, ExperimentVariantReportDTO{
+			Variant:      v.Name,
+			Users:        st.Users,
+			Exposures:    st.Exposures,
+			Clicks:       st.Clicks,
+			CTR:          rate(st.Clicks, st.Exposures),
+			Likes:        st.Likes,
+			LikeRate:     rate(st.Likes, st.Exposures),
+			Dislikes:     st.Dislikes,
+			DislikeRate:  rate(st.Dislikes, st.Exposures),
+			Bookmarks:    st.Bookmarks,
+			BookmarkRate: rate(st.Bookmarks, st.Exposures),
+		})
+	}
+
+	return &ExperimentReportDTO{
+		Experiment: exp,
+		Since:      since,
+		Variants:   variants,
+	}, nil
+}
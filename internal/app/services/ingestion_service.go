@@ -0,0 +1,195 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"newsclip/backend/internal/app/models"
+	"newsclip/backend/internal/app/repositories"
+	"newsclip/backend/pkg/navernews"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// 수집 실행 주체
+const (
+	IngestionTriggerSchedule = "schedule"
+	IngestionTriggerManual   = "manual"
+)
+
+// [수정] 10개 -> 5개
+const displayPerCategory = 5
+
+// 동시에 하나의 수집만 실행 (스케줄 실행과 수동 실행이 겹치지 않도록)
+var ingestionInProgress atomic.Bool
+
+// === 모든 카테고리 뉴스를 병렬로 수집하는 함수 ===
+// === FetchAllCategories ===
+// [수정] 실행 기록(IngestionRun)을 남기고, 모든 카테고리가 실패하면 오류 반환
+func FetchAllCategories() error {
+	run, err := beginIngestionRun(IngestionTriggerSchedule)
+	if err != nil {
+		return err
+	}
+	executeIngestionRun(run)
+
+	if run.Status == models.IngestionFailed {
+		return fmt.Errorf("all %d categories failed", run.ErrorCount)
+	}
+	return nil
+}
+
+// === [관리자] 수동 수집 실행 ===
+// 실행 기록을 만든 뒤 백그라운드에서 수집 (진행 상황은 실행 기록 목록으로 확인)
+func StartManualIngestion() (*models.IngestionRun, error) {
+	run, err := beginIngestionRun(IngestionTriggerManual)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshot := *run
+	go executeIngestionRun(run)
+	return &snapshot, nil
+}
+
+// (내부 함수) 실행 기록 생성 (이미 실행 중이면 오류)
+func beginIngestionRun(trigger string) (*models.IngestionRun, error) {
+	if !ingestionInProgress.CompareAndSwap(false, true) {
+		return nil, errors.New("이미 수집 작업이 실행 중입니다")
+	}
+
+	run := &models.IngestionRun{
+		Trigger:   trigger,
+		Status:    models.IngestionRunning,
+		StartedAt: time.Now(),
+	}
+	if err := repositories.CreateIngestionRun(run); err != nil {
+		ingestionInProgress.Store(false)
+		return nil, err
+	}
+	return run, nil
+}
+
+// (내부 함수) 카테고리별 병렬 수집 후 실행 기록 마무리
+func executeIngestionRun(run *models.IngestionRun) {
+	defer ingestionInProgress.Store(false)
+
+	categories := NewsCategories
+	log.Printf("[Scheduler] Starting fetch #%d (%s) for %d categories (%d items each)...",
+		run.ID, run.Trigger, len(categories), displayPerCategory)
+
+	results := make([]models.IngestionCategoryRun, len(categories))
+
+	var wg sync.WaitGroup
+	wg.Add(len(categories))
+
+	for i, category := range categories {
+		i, cat := i, category
+		go func() {
+			defer wg.Done()
+			log.Printf("[Scheduler] ... fetching category: %s", cat)
+			results[i] = fetchCategoryForRun(run.ID, cat)
+		}()
+	}
+
+	wg.Wait()
+
+	// 집계
+	for _, r := range results {
+		run.Fetched += r.Fetched
+		run.Duplicates += r.Duplicates
+		run.Stored += r.Stored
+		if r.Error != "" {
+			run.ErrorCount++
+		}
+	}
+
+	switch {
+	case run.ErrorCount == 0:
+		run.Status = models.IngestionSuccess
+	case run.ErrorCount < len(categories):
+		run.Status = models.IngestionPartial
+	default:
+		run.Status = models.IngestionFailed
+	}
+	finishedAt := time.Now()
+	run.FinishedAt = &finishedAt
+
+	if err := repositories.UpdateIngestionRun(run); err != nil {
+		log.Printf("🔥 [Scheduler] Failed to save ingestion run #%d: %v", run.ID, err)
+	}
+	log.Printf("[Scheduler] Fetch #%d finished: %s (fetched %d, stored %d, duplicates %d, failed categories %d)",
+		run.ID, run.Status, run.Fetched, run.Stored, run.Duplicates, run.ErrorCount)
+}
+
+// (내부 함수) 카테고리 1개 수집 + 결과 기록
+func fetchCategoryForRun(runID uint, category string) models.IngestionCategoryRun {
+	categoryRun := models.IngestionCategoryRun{
+		RunID:     runID,
+		Category:  category,
+		StartedAt: time.Now(),
+	}
+	if err := repositories.CreateIngestionCategoryRun(&categoryRun); err != nil {
+		log.Printf("🔥 [Scheduler] Failed to create category run for %s: %v", category, err)
+	}
+
+	stats, err := FetchAndStoreNews(category, displayPerCategory, categoryRun.ID)
+
+	categoryRun.Fetched = stats.Fetched
+	categoryRun.Duplicates = stats.Duplicates
+	categoryRun.Stored = stats.Stored
+	if err != nil {
+		categoryRun.Error = err.Error()
+		if errors.Is(err, navernews.ErrQuotaExceeded) {
+			log.Printf("⛔ [Scheduler] Naver daily quota exhausted, skipping category %s (%+v)", category, navernews.NewClient().Quota().Usage())
+		} else {
+			log.Printf("🔥 [Scheduler] FAILED category %s: %v", category, err)
+		}
+	}
+	finishedAt := time.Now()
+	categoryRun.FinishedAt = &finishedAt
+
+	if categoryRun.ID != 0 {
+		if err := repositories.UpdateIngestionCategoryRun(&categoryRun); err != nil {
+			log.Printf("🔥 [Scheduler] Failed to save category run for %s: %v", category, err)
+		}
+	}
+	return categoryRun
+}
+
+// === 서버 시작 시 중단된 수집 기록 정리 ===
+func CloseInterruptedIngestionRuns() {
+	count, err := repositories.MarkInterruptedIngestionRuns(time.Now())
+	if err != nil {
+		log.Printf("🔥 [Scheduler] Failed to close interrupted ingestion runs: %v", err)
+		return
+	}
+	if count > 0 {
+		log.Printf("⚠️ [Scheduler] Marked %d interrupted ingestion runs as failed", count)
+	}
+}
+
+// === [관리자] 수집 실행 기록 목록 DTO ===
+type IngestionRunListDTO struct {
+	Runs       []models.IngestionRun `json:"runs"`
+	TotalItems int64                 `json:"totalItems"`
+	TotalPages int                   `json:"totalPages"`
+}
+
+// === [관리자] 수집 실행 기록 목록 조회 ===
+func GetIngestionRuns(page int, size int) (*IngestionRunListDTO, error) {
+	runs, totalItems, totalPages, err := repositories.FindIngestionRuns(page, size)
+	if err != nil {
+		return nil, err
+	}
+	if runs == nil {
+		runs = []models.IngestionRun{}
+	}
+
+	return &IngestionRunListDTO{
+		Runs:       runs,
+		TotalItems: totalItems,
+		TotalPages: totalPages,
+	}, nil
+}
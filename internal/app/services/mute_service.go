@@ -0,0 +1,130 @@
+package services
+
+import (
+	"errors"
+	"newsclip/backend/internal/app/models"
+	"newsclip/backend/internal/app/repositories"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// "관심 없음" 피드백 종류
+const (
+	MuteTypeNews     = "news"     // 기사 숨김
+	MuteTypeSource   = "source"   // 언론사 뮤트
+	MuteTypeCategory = "category" // 카테고리 뮤트
+	MuteTypeKeyword  = "keyword"  // 제목 키워드 뮤트
+)
+
+// 키워드 뮤트 글자 수 제한
+const (
+	muteKeywordMinLength = 2
+	muteKeywordMaxLength = 30
+)
+
+// === 뮤트 목록 응답 DTO ===
+type MuteSettingsDTO struct {
+	HiddenNews []models.UserMute `json:"hidden_news"`
+	Sources    []models.UserMute `json:"sources"`
+	Categories []models.UserMute `json:"categories"`
+	Keywords   []models.UserMute `json:"keywords"`
+}
+
+// === "관심 없음" 피드백 추가 서비스 ===
+func AddUserMute(userID uint, muteType string, value string) (*models.UserMute, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return nil, errors.New("뮤트할 값이 비어 있습니다")
+	}
+
+	// 1. 종류별 검증
+	switch muteType {
+	case MuteTypeNews:
+		newsID, err := strconv.ParseUint(value, 10, 32)
+		if err != nil {
+			return nil, errors.New("잘못된 뉴스 ID입니다")
+		}
+		if _, err := repositories.FindNewsByID(uint(newsID)); err != nil {
+			return nil, errors.New("뉴스를 찾을 수 없습니다")
+		}
+		value = strconv.FormatUint(newsID, 10) // "007" 같은 입력 정규화
+
+	case MuteTypeKeyword:
+		length := utf8.RuneCountInString(value)
+		if length < muteKeywordMinLength || length > muteKeywordMaxLength {
+			return nil, errors.New("키워드는 2자 이상 30자 이하로 입력해주세요")
+		}
+
+	case MuteTypeSource, MuteTypeCategory:
+		if utf8.RuneCountInString(value) > 100 {
+			return nil, errors.New("뮤트할 값이 너무 깁니다")
+		}
+
+	default:
+		return nil, errors.New("지원하지 않는 뮤트 종류입니다")
+	}
+
+	// 2. 언론사 뮤트는 레지스트리 언론사로 정규화 (도메인/다른 표기 → 정식 이름)
+	var publisherID *uint
+	if muteType == MuteTypeSource {
+		if publisher, ok := ResolvePublisherByValue(value); ok {
+			value = publisher.Name
+			publisherID = &publisher.ID
+		}
+	}
+
+	// 3. 저장 (이미 있으면 기존 것 반환)
+	mute := &models.UserMute{
+		UserID:      userID,
+		MuteType:    muteType,
+		Value:       value,
+		PublisherID: publisherID,
+	}
+	if err := repositories.CreateUserMute(mute); err != nil {
+		return nil, err
+	}
+
+	return mute, nil
+}
+
+// === 뮤트 목록 조회 서비스 (종류별로 묶어서 반환) ===
+func GetUserMutes(userID uint) (*MuteSettingsDTO, error) {
+	mutes, err := repositories.FindUserMutes(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	settings := &MuteSettingsDTO{
+		HiddenNews: []models.UserMute{},
+		Sources:    []models.UserMute{},
+		Categories: []models.UserMute{},
+		Keywords:   []models.UserMute{},
+	}
+	for _, m := range mutes {
+		switch m.MuteType {
+		case MuteTypeNews:
+			settings.HiddenNews = append(settings.HiddenNews, m)
+		case MuteTypeSource:
+			settings.Sources = append(settings.Sources, m)
+		case MuteTypeCategory:
+			settings.Categories = append(settings.Categories, m)
+		case MuteTypeKeyword:
+			settings.Keywords = append(settings.Keywords, m)
+		}
+	}
+
+	return settings, nil
+}
+
+// === 뮤트 해제 서비스 ===
+func RemoveUserMute(userID, muteID uint) error {
+	deleted, err := repositories.DeleteUserMute(userID, muteID)
+	if err != nil {
+		return err
+	}
+	if deleted == 0 {
+		return errors.New("뮤트 설정을 찾을 수 없습니다")
+	}
+	return nil
+}
@@ -0,0 +1,181 @@
+package services
+
+import (
+	"errors"
+	"log"
+	"newsclip/backend/internal/app/models"
+	"newsclip/backend/internal/app/repositories"
+	"newsclip/backend/pkg/fetcher"
+	"sync"
+	"time"
+)
+
+// 메타데이터 보강 워커 풀 설정
+const (
+	newsEnrichmentWorkers   = 8   // 동시에 원문 페이지를 가져오는 워커 수 (호스트별 제한은 수집기가 별도로 적용)
+	newsEnrichmentQueueSize = 500 // 대기열이 가득 차면 pending 으로 남겨 두고 주기 작업에서 다시 처리
+	newsEnrichmentSweepDays = 3   // 주기 작업에서 다시 보강할 기사 범위 (일)
+	newsEnrichmentSweepSize = 200
+)
+
+// 보강 작업 1건
+type newsEnrichmentJob struct {
+	NewsID        uint
+	OriginalURL   string
+	CategoryRunID uint // 수집 기록 (실패 집계용, 없으면 0)
+}
+
+var (
+	enrichmentOnce  sync.Once
+	enrichmentQueue chan newsEnrichmentJob
+)
+
+// 대기열 + 처리 중인 작업 수
+// (WaitGroup 은 Wait 도중 Add 를 허용하지 않으므로, 카운터 + 종료 알림 채널로 관리)
+var (
+	enrichmentMu       sync.Mutex
+	enrichmentInFlight int
+	enrichmentIdle     chan struct{} // 작업 수가 0이 되면 close (대기 중인 호출자 모두 깨움)
+)
+
+// (내부 함수) 작업 1건 시작 기록
+func enrichmentStarted() {
+	enrichmentMu.Lock()
+	defer enrichmentMu.Unlock()
+	if enrichmentInFlight == 0 {
+		enrichmentIdle = make(chan struct{})
+	}
+	enrichmentInFlight++
+}
+
+// (내부 함수) 작업 1건 종료 기록
+func enrichmentFinished() {
+	enrichmentMu.Lock()
+	defer enrichmentMu.Unlock()
+	enrichmentInFlight--
+	if enrichmentInFlight == 0 {
+		close(enrichmentIdle)
+	}
+}
+
+// (내부 함수) 워커 풀 시작 (최초 1회)
+func startNewsEnrichmentWorkers() {
+	enrichmentOnce.Do(func() {
+		enrichmentQueue = make(chan newsEnrichmentJob, newsEnrichmentQueueSize)
+		for i := 0; i < newsEnrichmentWorkers; i++ {
+			go func() {
+				for job := range enrichmentQueue {
+					enrichNews(job)
+					enrichmentFinished()
+				}
+			}()
+		}
+		log.Printf("🖼️ [Enrichment] Started %d workers", newsEnrichmentWorkers)
+	})
+}
+
+// === 뉴스 메타데이터(이미지/언론사) 보강 요청 ===
+// 대기열이 가득 차면 건너뜀 (pending 상태로 남아 EnrichPendingNews 에서 다시 처리)
+// categoryRunID 가 있으면 보강 실패를 해당 수집 기록에 집계
+func EnqueueNewsEnrichment(newsList []models.News, categoryRunID uint) {
+	startNewsEnrichmentWorkers()
+
+	skipped := 0
+	for _, news := range newsList {
+		if news.ID == 0 {
+			continue
+		}
+		job := newsEnrichmentJob{NewsID: news.ID, OriginalURL: news.OriginalURL, CategoryRunID: categoryRunID}
+		if job.OriginalURL == "" {
+			job.OriginalURL = news.URL
+		}
+
+		enrichmentStarted()
+		select {
+		case enrichmentQueue <- job:
+		default:
+			enrichmentFinished()
+			skipped++
+		}
+	}
+
+	if skipped > 0 {
+		log.Printf("⚠️ [Enrichment] Queue full, %d items left pending", skipped)
+	}
+}
+
+// === 대기 중인 보강 작업이 끝날 때까지 대기 (최대 timeout) ===
+// (쇼츠 생성 전에 이미지가 채워지도록 수집 작업 뒤에 호출)
+// (대기 중에 다른 수집/주기 작업이 새 작업을 넣어도 안전하며, timeout 이후 남는 고루틴 없음)
+func WaitForNewsEnrichment(timeout time.Duration) bool {
+	enrichmentMu.Lock()
+	if enrichmentInFlight == 0 {
+		enrichmentMu.Unlock()
+		return true
+	}
+	idle := enrichmentIdle
+	enrichmentMu.Unlock()
+
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	select {
+	case <-idle:
+		return true
+	case <-timer.C:
+		return false
+	}
+}
+
+// === 보강되지 않은 최근 기사 재처리 (주기 작업) ===
+// 서버 재시작이나 대기열 초과로 pending 에 남은 기사를 다시 대기열에 넣음
+func EnrichPendingNews() {
+	since := time.Now().AddDate(0, 0, -newsEnrichmentSweepDays)
+	pending, err := repositories.FindNewsPendingEnrichment(since, newsEnrichmentSweepSize)
+	if err != nil {
+		log.Printf("🔥 [Enrichment] Failed to load pending news: %v", err)
+		return
+	}
+	if len(pending) == 0 {
+		return
+	}
+
+	log.Printf("🖼️ [Enrichment] Re-queueing %d pending news", len(pending))
+	EnqueueNewsEnrichment(pending, 0)
+}
+
+// (내부 함수) 기사 1건 보강
+func enrichNews(job newsEnrichmentJob) {
+	imageURL, siteName, err := getPageMetadata(job.OriginalURL)
+
+	status := models.EnrichmentDone
+	if err != nil {
+		// 일시적인 오류(타임아웃/5xx)는 pending 으로 남겨 다음 주기에 재시도
+		var statusErr *fetcher.StatusError
+		if errors.Is(err, fetcher.ErrDisallowedByRobots) || errors.Is(err, fetcher.ErrBodyTooLarge) ||
+			(errors.As(err, &statusErr) && statusErr.StatusCode < 500 && statusErr.StatusCode != 429) {
+			status = models.EnrichmentFailed
+		} else {
+			status = models.EnrichmentPending
+		}
+		log.Printf("⚠️ [Enrichment] Failed for NewsID %d (%s): %v", job.NewsID, job.OriginalURL, err)
+
+		if job.CategoryRunID != 0 {
+			if err := repositories.IncrementIngestionEnrichmentFailures(job.CategoryRunID); err != nil {
+				log.Printf("🔥 [Enrichment] Failed to record failure for run %d: %v", job.CategoryRunID, err)
+			}
+		}
+	}
+
+	// 언론사 레지스트리와 매칭되면 정식 이름으로, 아니면 og:site_name 그대로 사용
+	source := siteName
+	var publisherID *uint
+	if publisher, ok := ResolvePublisher(job.OriginalURL, siteName); ok {
+		source = publisher.Name
+		publisherID = &publisher.ID
+	}
+
+	if err := repositories.UpdateNewsEnrichment(job.NewsID, imageURL, source, publisherID, status); err != nil {
+		log.Printf("🔥 [Enrichment] Failed to save NewsID %d: %v", job.NewsID, err)
+	}
+}
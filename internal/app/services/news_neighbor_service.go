@@ -0,0 +1,92 @@
+package services
+
+import (
+	"log"
+	"newsclip/backend/internal/app/models"
+	"newsclip/backend/internal/app/repositories"
+)
+
+// 아이템 기반 협업 필터링 설정값
+const (
+	newsNeighborWindowDays = 30 // 최근 30일 이내 좋아요/북마크만 사용
+	newsNeighborTopK       = 20 // 뉴스별 저장할 이웃 수
+	newsNeighborMinCoCount = 2  // 최소 공동 반응 사용자 수 (우연한 1회 겹침 제외)
+	relatedNewsDefaultSize = 10
+	relatedNewsMaxSize     = 30
+)
+
+// === 연관 뉴스 응답 DTO ===
+type RelatedNewsResponseDTO struct {
+	News []RecommendedNewsItemDTO `json:"news"`
+}
+
+// === 뉴스 이웃(연관 기사) 재계산 작업 ===
+func RebuildNewsNeighbors() error {
+	log.Println("🔗 [Neighbor Job] Rebuilding item-item news neighbors...")
+
+	count, err := repositories.RebuildNewsNeighbors(newsNeighborWindowDays, newsNeighborTopK, newsNeighborMinCoCount)
+	if err != nil {
+		log.Printf("🔥 [Neighbor Job] FAILED: %v", err)
+		return err
+	}
+
+	log.Printf("✅ [Neighbor Job] Saved %d news neighbors.", count)
+	return nil
+}
+
+// === 연관 뉴스 조회 서비스 ===
+// 협업 필터링 이웃을 우선 사용하고, 부족하면 같은 카테고리 최신 기사로 채움
+func GetRelatedNews(newsID uint, size int) (*RelatedNewsResponseDTO, error) {
+	if size <= 0 {
+		size = relatedNewsDefaultSize
+	}
+	if size > relatedNewsMaxSize {
+		size = relatedNewsMaxSize
+	}
+
+	// 1. 기준 뉴스 존재 확인 (없으면 gorm.ErrRecordNotFound)
+	news, err := repositories.FindNewsByID(newsID)
+	if err != nil {
+		return nil, err
+	}
+
+	// 2. 협업 필터링 이웃
+	related, err := repositories.FindRelatedNews(newsID, size)
+	if err != nil {
+		return nil, err
+	}
+
+	// 3. 부족하면 같은 카테고리 최신 기사로 채우기 (신규 기사 등 반응 데이터가 없는 경우)
+	if len(related) < size {
+		excludeIDs := []uint{newsID}
+		for _, n := range related {
+			excludeIDs = append(excludeIDs, n.ID)
+		}
+
+		fill, err := repositories.FindRecentNewsInCategory(news.Category, excludeIDs, size-len(related))
+		if err != nil {
+			return nil, err
+		}
+		related = append(related, fill...)
+	}
+
+	return &RelatedNewsResponseDTO{
+		News: toRecommendedNewsItems(related),
+	}, nil
+}
+
+// (헬퍼 함수) 뉴스 목록을 추천 뉴스 DTO로 변환
+func toRecommendedNewsItems(newsList []models.News) []RecommendedNewsItemDTO {
+	items := make([]RecommendedNewsItemDTO, len(newsList))
+	for i, n := range newsList {
+		items[i] = RecommendedNewsItemDTO{
+			NewsID:      n.ID,
+			Title:       n.Title,
+			Source:      n.Source,
+			Category:    n.Category,
+			ImageURL:    n.ImageURL,
+			PublishedAt: n.PublishedAt,
+		}
+	}
+	return items
+}
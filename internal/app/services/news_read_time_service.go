@@ -0,0 +1,103 @@
+package services
+
+import (
+	"log"
+	"newsclip/backend/config"
+	"newsclip/backend/internal/app/repositories"
+	"newsclip/backend/pkg/readtime"
+)
+
+// 환경 변수에서 읽기 속도 로드 (READ_TIME_CHARS_PER_MINUTE, READ_TIME_WORDS_PER_MINUTE)
+func loadReadTimeSpeed() readtime.Speed {
+	return readtime.Speed{
+		CharsPerMinute: config.GetEnvFloat("READ_TIME_CHARS_PER_MINUTE", readtime.DefaultSpeed.CharsPerMinute),
+		WordsPerMinute: config.GetEnvFloat("READ_TIME_WORDS_PER_MINUTE", readtime.DefaultSpeed.WordsPerMinute),
+	}
+}
+
+// (헬퍼 함수) 원문 본문 기준 예상 읽기 시간 (본문이 없으면 0)
+func estimateReadTime(fullContent string) int {
+	return readtime.Minutes(fullContent, loadReadTimeSpeed())
+}
+
+// === 읽기 시간 일괄 계산 옵션 ===
+type ReadTimeBackfillOptions struct {
+	BatchSize  int  // 재계산 시 1회 조회 개수
+	Crawl      bool // 아직 크롤링하지 않은 기사의 원문도 수집
+	Recrawl    bool // 이미 크롤링한 기사의 원문을 다시 수집
+	CrawlLimit int  // 수집할 최대 기사 수 (최신순)
+	DryRun     bool // 저장하지 않고 변경 예정 수만 집계 (크롤링도 하지 않음)
+}
+
+// === 읽기 시간 일괄 계산 결과 ===
+type ReadTimeBackfillReportDTO struct {
+	Scanned      int `json:"scanned"`      // 원문 본문이 있는 기사 수
+	Updated      int `json:"updated"`      // 읽기 시간이 바뀐 기사 수 (dry run 이면 바뀔 예정 수)
+	Crawled      int `json:"crawled"`      // 새로 (재)크롤링한 기사 수
+	CrawlFailed  int `json:"crawlFailed"`  // 크롤링 실패 수
+	WithoutBody  int `json:"withoutBody"`  // 크롤링했지만 본문을 찾지 못한 기사 수 (기본값 유지)
+	UpdateFailed int `json:"updateFailed"` // 저장 실패 수
+}
+
+// === 기존 기사 읽기 시간 일괄 계산 (cmd/readtime) ===
+//  1. (선택) 원문을 아직 수집하지 않았거나 다시 수집할 기사를 크롤링 (저장 시 읽기 시간 계산)
+//  2. 원문 본문이 있는 모든 기사의 읽기 시간을 현재 읽기 속도 기준으로 재계산
+func BackfillReadTimes(opts ReadTimeBackfillOptions) (*ReadTimeBackfillReportDTO, error) {
+	if opts.BatchSize < 1 {
+		opts.BatchSize = 500
+	}
+	report := &ReadTimeBackfillReportDTO{}
+
+	// 1. 원문 크롤링
+	if !opts.DryRun && (opts.Crawl || opts.Recrawl) && opts.CrawlLimit > 0 {
+		newsList, err := repositories.FindNewsForContentCrawl(opts.Recrawl, opts.CrawlLimit)
+		if err != nil {
+			return nil, err
+		}
+		for i := range newsList {
+			news := &newsList[i]
+			if err := RecrawlNewsContent(news); err != nil {
+				log.Printf("⚠️ [ReadTime] Failed to crawl NewsID %d: %v", news.ID, err)
+				report.CrawlFailed++
+				continue
+			}
+			report.Crawled++
+			if news.FullContent == "" {
+				report.WithoutBody++
+			}
+		}
+	}
+
+	// 2. 원문이 있는 기사 재계산 (ID 커서 페이징)
+	var afterID uint = 0
+	for {
+		newsList, err := repositories.FindNewsWithFullContent(afterID, opts.BatchSize)
+		if err != nil {
+			return nil, err
+		}
+		if len(newsList) == 0 {
+			break
+		}
+
+		for _, news := range newsList {
+			report.Scanned++
+			minutes := estimateReadTime(news.FullContent)
+			if minutes == 0 || minutes == news.ReadTimeMinutes {
+				continue
+			}
+			if opts.DryRun {
+				report.Updated++
+				continue
+			}
+			if err := repositories.UpdateNewsReadTime(news.ID, minutes); err != nil {
+				log.Printf("⚠️ [ReadTime] Failed to update NewsID %d: %v", news.ID, err)
+				report.UpdateFailed++
+				continue
+			}
+			report.Updated++
+		}
+		afterID = newsList[len(newsList)-1].ID
+	}
+
+	return report, nil
+}
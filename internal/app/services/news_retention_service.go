@@ -0,0 +1,123 @@
+package services
+
+import (
+	"log"
+	"newsclip/backend/config"
+	"newsclip/backend/internal/app/repositories"
+	"time"
+)
+
+// === 뉴스 보관 정책 ===
+// 보관 기간이 지난 뉴스 중 북마크/댓글/쇼츠가 연결된 기사는 그대로 두고,
+// 나머지는 요약 보관본(archived_news)으로 옮긴 뒤 원본과 좋아요/연관 기사/실험 노출 기록을 삭제
+type NewsRetentionPolicy struct {
+	RetentionDays        int `json:"retentionDays"`        // 이 기간이 지난 뉴스를 보관 처리 (NEWS_RETENTION_DAYS)
+	BatchSize            int `json:"batchSize"`            // 트랜잭션 1회당 처리 개수 (NEWS_RETENTION_BATCH_SIZE)
+	ArchiveRetentionDays int `json:"archiveRetentionDays"` // 보관본 유지 기간, 0이면 영구 보관 (NEWS_ARCHIVE_RETENTION_DAYS)
+}
+
+// 환경 변수에서 보관 정책 로드
+func LoadNewsRetentionPolicy() NewsRetentionPolicy {
+	policy := NewsRetentionPolicy{
+		RetentionDays:        int(config.GetEnvFloat("NEWS_RETENTION_DAYS", 14)),
+		BatchSize:            int(config.GetEnvFloat("NEWS_RETENTION_BATCH_SIZE", 500)),
+		ArchiveRetentionDays: int(config.GetEnvFloat("NEWS_ARCHIVE_RETENTION_DAYS", 0)),
+	}
+	if policy.RetentionDays < 1 {
+		policy.RetentionDays = 14
+	}
+	if policy.BatchSize < 1 {
+		policy.BatchSize = 500
+	}
+	return policy
+}
+
+// === 보관 처리 리포트 ===
+type NewsRetentionReportDTO struct {
+	Policy NewsRetentionPolicy `json:"policy"`
+	DryRun bool                `json:"dryRun"`
+	Cutoff time.Time           `json:"cutoff"`
+
+	Archived            int64 `json:"archived"` // dry run 이면 보관 예정 수
+	KeptBookmarked      int64 `json:"keptBookmarked"`
+	KeptCommented       int64 `json:"keptCommented"`
+	KeptShortLinked     int64 `json:"keptShortLinked"`
+	DeletedInteractions int64 `json:"deletedInteractions"`
+	DeletedNeighbors    int64 `json:"deletedNeighbors"`
+	DeletedExposures    int64 `json:"deletedExposures"`
+	PurgedArchives      int64 `json:"purgedArchives"` // 보관본 유지 기간이 지나 영구 삭제된 수
+}
+
+// === 뉴스 보관 처리 실행 ===
+// dryRun 이면 아무것도 바꾸지 않고 대상 수만 집계
+func RunNewsRetention(dryRun bool) (*NewsRetentionReportDTO, error) {
+	policy := LoadNewsRetentionPolicy()
+	now := time.Now()
+
+	report := &NewsRetentionReportDTO{
+		Policy: policy,
+		DryRun: dryRun,
+		Cutoff: now.AddDate(0, 0, -policy.RetentionDays),
+	}
+
+	// 1. 남겨두는 기사 집계
+	kept, err := repositories.CountNewsRetentionKept(report.Cutoff)
+	if err != nil {
+		return nil, err
+	}
+	report.KeptBookmarked = kept.Bookmarked
+	report.KeptCommented = kept.Commented
+	report.KeptShortLinked = kept.ShortLinked
+
+	if dryRun {
+		report.Archived, err = repositories.CountNewsArchiveCandidates(report.Cutoff)
+		if err != nil {
+			return nil, err
+		}
+		return report, nil
+	}
+
+	// 2. 배치 단위로 보관 처리 (대상이 없을 때까지)
+	for {
+		batch, err := repositories.ArchiveNewsBatch(report.Cutoff, policy.BatchSize, now)
+		if err != nil {
+			return report, err
+		}
+		report.Archived += batch.Archived
+		report.DeletedInteractions += batch.DeletedInteractions
+		report.DeletedNeighbors += batch.DeletedNeighbors
+		report.DeletedExposures += batch.DeletedExposures
+
+		if batch.Archived < int64(policy.BatchSize) {
+			break
+		}
+	}
+
+	// 3. 오래된 보관본 정리
+	if policy.ArchiveRetentionDays > 0 {
+		report.PurgedArchives, err = repositories.PurgeArchivedNewsBefore(now.AddDate(0, 0, -policy.ArchiveRetentionDays))
+		if err != nil {
+			return report, err
+		}
+	}
+
+	return report, nil
+}
+
+// === 오래된 뉴스 정리 서비스 (스케줄러) ===
+// [수정] 바로 삭제하지 않고 보관 정책에 따라 보관 처리
+func CleanupOldNews() error {
+	log.Println("[Cleaner] Running news retention policy...")
+
+	report, err := RunNewsRetention(false)
+	if err != nil {
+		log.Printf("🔥 [Cleaner] FAILED: %v", err)
+		return err
+	}
+
+	log.Printf("✅ [Cleaner] Archived %d news older than %s (kept: bookmarked %d, commented %d, shorts %d; removed: interactions %d, neighbors %d, exposures %d; purged archives %d)",
+		report.Archived, report.Cutoff.Format("2006-01-02"),
+		report.KeptBookmarked, report.KeptCommented, report.KeptShortLinked,
+		report.DeletedInteractions, report.DeletedNeighbors, report.DeletedExposures, report.PurgedArchives)
+	return nil
+}
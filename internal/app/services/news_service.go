@@ -4,14 +4,12 @@ import (
 	"errors"
 	"html"
 	"log"
-	"net/http"
 	"net/url"
 	"newsclip/backend/config"
 	"newsclip/backend/internal/app/models"
 	"newsclip/backend/internal/app/repositories"
 	"newsclip/backend/pkg/navernews"
 	"regexp"
-	"sync"
 	"time"
 
 	"github.com/PuerkitoBio/goquery"
@@ -36,17 +34,8 @@ func cleanString(s string) string {
 // === 함수명 변경 및 기능 확장 (og:image + og:site_name) ===
 // (url) -> (imageURL, siteName, error)
 func getPageMetadata(url string) (string, string, error) {
-	res, err := http.Get(url)
-	if err != nil {
-		return "", "", err
-	}
-	defer res.Body.Close()
-
-	if res.StatusCode != 200 {
-		return "", "", log.Output(2, "request failed")
-	}
-
-	doc, err := goquery.NewDocumentFromReader(res.Body)
+	// [수정] 공용 수집기 사용 (타임아웃, robots.txt, 문자셋 변환 등)
+	doc, _, err := fetchDocument(url)
 	if err != nil {
 		return "", "", err
 	}
@@ -69,84 +58,93 @@ func getPageMetadata(url string) (string, string, error) {
 	return imageURL, siteName, nil
 }
 
-// === 모든 카테고리 뉴스를 병렬로 수집하는 함수 ===
-// === FetchAllCategories ===
-func FetchAllCategories() error {
-	categories := []string{
-		"정치", "경제", "문화", "환경", "기술", "스포츠",
-		"라이프스타일", "건강", "교육", "음식", "여행", "패션",
-	}
-
-	// [수정] 10개 -> 5개
-	displayPerCategory := 5
-
-	log.Printf("[Scheduler] Starting fetch for %d categories (%d items each)...", len(categories), displayPerCategory)
-
-	var wg sync.WaitGroup
-	wg.Add(len(categories))
-
-	for _, category := range categories {
-		cat := category
-		go func() {
-			defer wg.Done()
-			log.Printf("[Scheduler] ... fetching category: %s", cat)
+// 뉴스 수집/선호 카테고리에 사용하는 카테고리 목록
+var NewsCategories = []string{
+	"정치", "경제", "문화", "환경", "기술", "스포츠",
+	"라이프스타일", "건강", "교육", "음식", "여행", "패션",
+}
 
-			// displayPerCategory (5)를 전달
-			err := FetchAndStoreNews(cat, displayPerCategory)
+// 카테고리당 최대 수집 페이지 수 기본값 (NAVER_FETCH_MAX_PAGES)
+const defaultNaverFetchMaxPages = 3
 
-			if err != nil {
-				log.Printf("🔥 [Scheduler] FAILED category %s: %v", cat, err)
-			}
-		}()
-	}
-
-	wg.Wait()
-	log.Println("[Scheduler] All category fetching routines finished.")
-	return nil
+// === 카테고리 1회 수집 결과 ===
+type IngestionStats struct {
+	Fetched    int // 네이버 API에서 받은 기사 수
+	Duplicates int // 이미 저장되어 있던 기사 수
+	Stored     int // 새로 저장한 기사 수
 }
 
 // === FetchAndStoreNews 함수 ===
 // (언론사명, 작성시간 추가)
-func FetchAndStoreNews(query string, display int) error {
+// [수정] 수집 결과 통계 반환, categoryRunID 는 보강 실패를 수집 기록에 남기기 위해 사용 (없으면 0)
+func FetchAndStoreNews(query string, display int, categoryRunID uint) (IngestionStats, error) {
+	var stats IngestionStats
 	client := navernews.NewClient()
 
-	resp, err := client.SearchNews(query, display, 1)
+	// [수정] 날짜순으로 마지막 수집 이후 기사만 여러 페이지에 걸쳐 가져옴 (증분 수집)
+	since, err := repositories.FindLatestPublishedAt(query)
+	if err != nil {
+		return stats, err
+	}
+
+	items, err := client.SearchNewsPaged(query, navernews.PageOptions{
+		Display:  display,
+		MaxPages: int(config.GetEnvFloat("NAVER_FETCH_MAX_PAGES", defaultNaverFetchMaxPages)),
+		Sort:     navernews.SortDate,
+		Since:    since,
+	})
+	stats.Fetched = len(items)
 	if err != nil {
-		return err
+		if len(items) == 0 {
+			return stats, err
+		}
+		// 일부 페이지만 실패한 경우 받은 만큼은 저장
+		log.Printf("⚠️ Naver paging stopped early for '%s': %v", query, err)
 	}
 
-	log.Printf("Fetched %d items for query '%s' from Naver.", len(resp.Items), query)
+	log.Printf("Fetched %d items for query '%s' from Naver.", len(items), query)
+
+	// [수정] 1. 중복 체크를 한 번의 쿼리로 처리
+	externalIDs := make([]string, len(items))
+	for i, item := range items {
+		externalIDs[i] = item.Link
+	}
+	existing, err := repositories.FindExistingExternalIDs(externalIDs)
+	if err != nil {
+		return stats, err
+	}
 
 	var newsToCreate []models.News
-	for _, item := range resp.Items {
+	for _, item := range items {
 
 		externalID := item.Link
-
-		_, err := repositories.FindNewsByExternalID(externalID)
-		if err == nil {
+		if existing[externalID] {
+			stats.Duplicates++
 			continue // 중복
 		}
+		existing[externalID] = true // 같은 응답 안의 중복도 제외
 
-		// --- [수정] 1. 메타데이터(이미지, 언론사) 가져오기 ---
-		imageURL, publisherName, err := getPageMetadata(item.Originallink)
-		if err != nil {
-			log.Printf("Failed to get metadata for %s: %v", item.Title, err)
-			imageURL = ""
+		// [수정] 메타데이터(이미지, 언론사)는 저장 후 비동기로 보강
+		// 우선 원문 링크의 호스트(도메인)를 언론사명으로 사용
+		originalURL := item.Originallink
+		if originalURL == "" {
+			originalURL = item.Link
+		}
+		publisherName := "Unknown" // 파싱 실패 시
+		if parsedURL, err := url.Parse(originalURL); err == nil && parsedURL.Host != "" {
+			publisherName = parsedURL.Host // 예: "www.yna.co.kr"
 		}
 
-		// [수정] 1-1. 언론사명이 비어있을 경우, 원문 링크의 호스트(도메인)로 대체
-		if publisherName == "" {
-			parsedURL, err := url.Parse(item.Originallink)
-			if err == nil {
-				publisherName = parsedURL.Host // 예: "www.yna.co.kr"
-			} else {
-				publisherName = "Unknown" // 파싱 실패 시
-			}
+		// [신규] 언론사 레지스트리에 등록된 도메인이면 정식 이름 사용
+		var publisherID *uint
+		if publisher, ok := ResolvePublisher(originalURL, ""); ok {
+			publisherName = publisher.Name
+			publisherID = &publisher.ID
 		}
 
 		// --- [수정] 2. 원본 기사 작성 시간(pubDate) 파싱 ---
 		// Naver API의 pubDate는 "RFC 1123Z" 형식 (예: Mon, 10 Nov 2025 14:30:00 +0900)
-		pubTime, err := time.Parse(time.RFC1123Z, item.PubDate)
+		pubTime, err := item.PublishedAt()
 		if err != nil {
 			// [수정] 3. 파싱 실패 시(요구사항 #3) 현재 시간으로 대체
 			log.Printf("Failed to parse pubDate '%s', using current time. Error: %v", item.PubDate, err)
@@ -161,45 +159,43 @@ func FetchAndStoreNews(query string, display int) error {
 			ExternalID:  externalID,
 			Title:       cleanTitle,
 			Content:     cleanDescription,
-			Source:      publisherName, // [수정] "연합뉴스" 또는 "www.yna.co.kr"
+			Source:      publisherName, // 레지스트리에 없으면 보강 후 og:site_name 으로 교체
+			PublisherID: publisherID,
 			URL:         item.Link,
+			OriginalURL: originalURL,
 			Category:    query,
-			ImageURL:    imageURL,
 			PublishedAt: pubTime, // [신규] 원본 기사 시간
+
+			EnrichmentStatus: models.EnrichmentPending,
 		})
 	}
 
-	// 3. DB에 일괄 저장
+	// 3. DB에 일괄 저장 (다른 카테고리에서 같은 기사를 먼저 저장한 경우 무시)
 	if len(newsToCreate) > 0 {
-		err = repositories.CreateNewsBatch(newsToCreate)
+		stored, err := repositories.CreateNewsBatch(newsToCreate)
 		if err != nil {
-			return err
+			return stats, err
+		}
+		stats.Stored = int(stored)
+		stats.Duplicates += len(newsToCreate) - stats.Stored // 동시에 다른 카테고리에서 저장된 기사
+		log.Printf("✅ Successfully stored %d new items for '%s' in DB.", stats.Stored, query)
+
+		// 4. 이미지/언론사 메타데이터 비동기 보강 (워커 풀)
+		storedIDs := make([]string, len(newsToCreate))
+		for i, n := range newsToCreate {
+			storedIDs[i] = n.ExternalID
+		}
+		pending, err := repositories.FindPendingNewsByExternalIDs(storedIDs)
+		if err != nil {
+			log.Printf("⚠️ Failed to load stored news for enrichment (%s): %v", query, err)
+		} else {
+			EnqueueNewsEnrichment(pending, categoryRunID)
 		}
-		log.Printf("✅ Successfully stored %d new items for '%s' in DB.", len(newsToCreate), query)
 	} else {
 		log.Printf("No new items to store for '%s'.", query)
 	}
 
-	return nil
-}
-
-// === 오래된 뉴스 삭제 서비스 ===
-func CleanupOldNews() error {
-	// 1. 기준 날짜(14일 전) 계산
-	// (0년, 0개월, -14일)
-	cutoffDate := time.Now().AddDate(0, 0, -14)
-
-	log.Printf("[Cleaner] Deleting news older than %s", cutoffDate.Format("2006-01-02"))
-
-	// 2. 레포지토리 호출
-	count, err := repositories.DeleteNewsOlderThan(cutoffDate)
-	if err != nil {
-		log.Printf("🔥 [Cleaner] FAILED: %v", err)
-		return err
-	}
-
-	log.Printf("✅ [Cleaner] Successfully deleted %d old news items.", count)
-	return nil
+	return stats, nil
 }
 
 // === 뉴스 목록 조회 서비스 ===
@@ -211,10 +207,10 @@ type NewsListDTO struct {
 	TotalPages int           `json:"totalPages"`
 }
 
-func GetNewsList(category string, page int, size int, userID uint) (*NewsListDTO, error) {
+func GetNewsList(category string, page int, size int, userID uint, publisherID uint) (*NewsListDTO, error) {
 
 	// 1. 레포지토리에서 데이터 조회
-	newsList, totalCount, totalPages, err := repositories.GetNewsByCategory(category, page, size)
+	newsList, totalCount, totalPages, err := repositories.GetNewsByCategory(category, page, size, userID, publisherID)
 	if err != nil {
 		return nil, err
 	}
@@ -243,6 +239,9 @@ type NewsDetailDTO struct {
 	IsBookmarked bool `json:"isBookmarked"`
 	IsLiked      bool `json:"isLiked"`
 	IsDisliked   bool `json:"isDisliked"`
+	IsArchived   bool `json:"isArchived"` // [신규] 보관 처리된 기사 (제목/원문 링크/언론사만 제공)
+
+	Tags []models.NewsTag `json:"tags"` // [신규] 자동 태그 (키워드/인물/기관/장소)
 }
 
 func GetNewsDetail(newsID uint, userID uint) (*NewsDetailDTO, error) {
@@ -267,6 +266,9 @@ func GetNewsDetail(newsID uint, userID uint) (*NewsDetailDTO, error) {
 		_ = repositories.IncrementNewsViewCount(newsID)
 	}()
 
+	// 2-1. (백그라운드) A/B 실험 노출 기사였다면 클릭으로 기록
+	MarkExperimentClicks(userID, "news", []uint{newsID})
+
 	// 3. [향후 로직] 사용자별 상호작용 정보 가져오기
 	//    (지금은 기본값으로 둡니다)
 	//    - go repositories.CheckBookmark(userID, newsID)
@@ -281,6 +283,12 @@ func GetNewsDetail(newsID uint, userID uint) (*NewsDetailDTO, error) {
 	case news = <-newsChan:
 		// 성공
 	case err := <-errChan:
+		// [신규] 보관 처리된 기사면 보관본으로 응답 (알림 딥링크 등 기존 링크 유지)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			if archived, archiveErr := repositories.FindArchivedNewsByID(newsID); archiveErr == nil {
+				return archivedNewsDetail(archived), nil
+			}
+		}
 		// 실패
 		return nil, err
 	}
@@ -291,11 +299,30 @@ func GetNewsDetail(newsID uint, userID uint) (*NewsDetailDTO, error) {
 		IsBookmarked: isBookmarked,
 		IsLiked:      isLiked,
 		IsDisliked:   isDisliked,
+		Tags:         getNewsTags(news.ID),
 	}
 
 	return response, nil
 }
 
+// (헬퍼 함수) 보관본을 상세 DTO로 변환
+func archivedNewsDetail(archived models.ArchivedNews) *NewsDetailDTO {
+	return &NewsDetailDTO{
+		News: models.News{
+			ID:          archived.ID,
+			ExternalID:  archived.ExternalID,
+			Title:       archived.Title,
+			URL:         archived.URL,
+			Source:      archived.Source,
+			Category:    archived.Category,
+			PublishedAt: archived.PublishedAt,
+			CreatedAt:   archived.CreatedAt,
+		},
+		IsArchived: true,
+		Tags:       []models.NewsTag{},
+	}
+}
+
 // === 상호작용 DTO ===
 type InteractionRequest struct {
 	InteractionType string `json:"interaction_type" binding:"required"`
@@ -0,0 +1,140 @@
+package services
+
+import (
+	"log"
+	"newsclip/backend/internal/app/models"
+	"newsclip/backend/internal/app/repositories"
+	"newsclip/backend/pkg/tagger"
+	"strings"
+	"sync/atomic"
+	"time"
+	"unicode/utf8"
+)
+
+// 태깅 설정값
+const (
+	tagBatchSize       = 300 // 1회 실행 시 태깅할 최대 기사 수
+	tagCorpusDays      = 3   // IDF 계산에 사용할 최근 기사 기간
+	tagCorpusMaxNews   = 3000
+	maxKeywordTags     = 5 // 기사당 키워드 태그 수
+	maxEntityTags      = 5 // 기사당 개체명(인물/기관/장소) 태그 수
+	maxTagNameLength   = 50
+	tagNewsDefaultSize = 10
+	tagNewsMaxSize     = 50
+)
+
+// 동시에 하나의 태깅 작업만 실행 (수집 직후 실행과 주기 실행이 겹치지 않도록)
+var taggingInProgress atomic.Bool
+
+// === 태깅되지 않은 기사 자동 태깅 ===
+// 최근 기사들로 단어 빈도(IDF)를 계산한 뒤, 기사마다 키워드/개체명을 추출해 저장
+func TagUntaggedNews() {
+	if !taggingInProgress.CompareAndSwap(false, true) {
+		return
+	}
+	defer taggingInProgress.Store(false)
+
+	// 1. 태깅 대상 조회
+	pending, err := repositories.FindNewsPendingTagging(tagBatchSize)
+	if err != nil {
+		log.Printf("🔥 [Tagger] Failed to load untagged news: %v", err)
+		return
+	}
+	if len(pending) == 0 {
+		return
+	}
+
+	// 2. 말뭉치(IDF) 구성 (원문 본문 기준, 없으면 요약)
+	corpusNews, err := repositories.FindNewsForTagCorpus(time.Now().AddDate(0, 0, -tagCorpusDays), tagCorpusMaxNews)
+	if err != nil {
+		log.Printf("🔥 [Tagger] Failed to load tag corpus: %v", err)
+		return
+	}
+	docs := make([]tagger.Document, len(corpusNews))
+	for i, n := range corpusNews {
+		docs[i] = tagger.Document{Title: n.Title, Body: n.Content}
+	}
+	corpus := tagger.NewCorpus(docs)
+
+	// 3. 기사별 태그 추출 + 저장
+	tagged, failed := 0, 0
+	for _, news := range pending {
+		tags := extractNewsTags(corpus, news)
+		if err := repositories.ReplaceNewsTags(news.ID, tags, time.Now()); err != nil {
+			log.Printf("🔥 [Tagger] Failed to save tags for news %d: %v", news.ID, err)
+			failed++
+			continue
+		}
+		tagged++
+	}
+	log.Printf("🏷️ [Tagger] Tagged %d news (failed %d)", tagged, failed)
+}
+
+// (헬퍼 함수) 기사 1건 태그 추출 (원문 본문이 있으면 원문, 없으면 요약 사용)
+func extractNewsTags(corpus *tagger.Corpus, news models.News) []models.NewsTag {
+	body := news.FullContent
+	if body == "" {
+		body = news.Content
+	}
+
+	extracted := corpus.Tags(tagger.Document{Title: news.Title, Body: body}, maxKeywordTags, maxEntityTags)
+
+	tags := make([]models.NewsTag, 0, len(extracted))
+	seen := make(map[string]bool, len(extracted))
+	for _, t := range extracted {
+		name := strings.TrimSpace(t.Name)
+		if name == "" || utf8.RuneCountInString(name) > maxTagNameLength || seen[name] {
+			continue
+		}
+		seen[name] = true
+		tags = append(tags, models.NewsTag{
+			NewsID:  news.ID,
+			Tag:     name,
+			TagType: t.Type,
+			Score:   t.Score,
+		})
+	}
+	return tags
+}
+
+// === 기사 태그 조회 (상세 화면용, 실패 시 빈 목록) ===
+func getNewsTags(newsID uint) []models.NewsTag {
+	tags, err := repositories.FindTagsByNewsID(newsID)
+	if err != nil || tags == nil {
+		return []models.NewsTag{}
+	}
+	return tags
+}
+
+// === 태그별 뉴스 목록 DTO ===
+type TagNewsListDTO struct {
+	Tag        string        `json:"tag"`
+	News       []models.News `json:"news"`
+	TotalItems int64         `json:"totalItems"`
+	TotalPages int           `json:"totalPages"`
+}
+
+// === 태그별 뉴스 목록 조회 서비스 ===
+func GetNewsByTag(tag string, page int, size int, userID uint) (*TagNewsListDTO, error) {
+	if size <= 0 {
+		size = tagNewsDefaultSize
+	}
+	if size > tagNewsMaxSize {
+		size = tagNewsMaxSize
+	}
+
+	newsList, totalCount, totalPages, err := repositories.GetNewsByTag(tag, page, size, userID)
+	if err != nil {
+		return nil, err
+	}
+	if newsList == nil {
+		newsList = []models.News{}
+	}
+
+	return &TagNewsListDTO{
+		Tag:        tag,
+		News:       newsList,
+		TotalItems: totalCount,
+		TotalPages: totalPages,
+	}, nil
+}
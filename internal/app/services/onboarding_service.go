@@ -0,0 +1,164 @@
+package services
+
+import (
+	"errors"
+	"newsclip/backend/config"
+	"newsclip/backend/internal/app/models"
+	"newsclip/backend/internal/app/repositories"
+
+	"gorm.io/gorm"
+)
+
+// 온보딩 설정값
+const (
+	onboardingSeedsPerCategory = 3  // 카테고리별 추천 시드 기사 수
+	onboardingMaxSeedNews      = 10 // 한 번에 선택할 수 있는 시드 기사 수
+)
+
+// === 온보딩 시드 기사 DTO ===
+type OnboardingCategorySeedsDTO struct {
+	Category string                   `json:"category"`
+	News     []RecommendedNewsItemDTO `json:"news"`
+}
+
+type OnboardingSeedsResponseDTO struct {
+	Categories []string                     `json:"categories"` // 선택 가능한 전체 카테고리
+	Seeds      []OnboardingCategorySeedsDTO `json:"seeds"`      // 카테고리별 인기 기사
+}
+
+// === 온보딩 완료 결과 DTO ===
+type OnboardingResultDTO struct {
+	Categories   []string `json:"categories"`
+	LikedNewsIDs []uint   `json:"likedNewsIds"` // 새로 좋아요 처리된 시드 기사
+}
+
+// === 온보딩 시드 기사 조회 서비스 ===
+// categories가 비어 있으면 전체 카테고리에서 인기 기사를 보여줌
+func GetOnboardingSeeds(categories []string) (*OnboardingSeedsResponseDTO, error) {
+	if len(categories) == 0 {
+		categories = NewsCategories
+	}
+	if err := validateNewsCategories(categories); err != nil {
+		return nil, err
+	}
+
+	seeds := make([]OnboardingCategorySeedsDTO, 0, len(categories))
+	for _, category := range categories {
+		newsList, err := findTrendingNews(category, onboardingSeedsPerCategory, 0)
+		if err != nil {
+			return nil, err
+		}
+
+		// 최근 인기 기사가 부족하면 같은 카테고리 최신 기사로 채우기
+		if len(newsList) < onboardingSeedsPerCategory {
+			excludeIDs := make([]uint, 0, len(newsList))
+			for _, n := range newsList {
+				excludeIDs = append(excludeIDs, n.ID)
+			}
+			fill, err := repositories.FindRecentNewsInCategory(category, excludeIDs, onboardingSeedsPerCategory-len(newsList))
+			if err != nil {
+				return nil, err
+			}
+			newsList = append(newsList, fill...)
+		}
+
+		seeds = append(seeds, OnboardingCategorySeedsDTO{
+			Category: category,
+			News:     toRecommendedNewsItems(newsList),
+		})
+	}
+
+	return &OnboardingSeedsResponseDTO{
+		Categories: NewsCategories,
+		Seeds:      seeds,
+	}, nil
+}
+
+// === 온보딩 완료 서비스 ===
+//   - 선택한 카테고리를 선호 카테고리(P1)로 저장 (기존 목록 교체)
+//   - 선택한 시드 기사는 '좋아요'로 기록 (이미 좋아요/싫어요한 기사는 그대로 둠)
+func CompleteOnboarding(userID uint, categories []string, seedNewsIDs []uint) (*OnboardingResultDTO, error) {
+	if len(categories) == 0 {
+		return nil, errors.New("카테고리를 하나 이상 선택해주세요")
+	}
+	if err := validateNewsCategories(categories); err != nil {
+		return nil, err
+	}
+	categories = uniqueStrings(categories)
+	if len(seedNewsIDs) > onboardingMaxSeedNews {
+		return nil, errors.New("선택할 수 있는 기사 수를 초과했습니다")
+	}
+
+	// 1. 선호 카테고리 저장
+	if err := SetPreferredCategories(userID, categories); err != nil {
+		return nil, err
+	}
+
+	// 2. 시드 기사 좋아요 (존재하는 기사만)
+	seedNews, err := repositories.FindNewsByIDs(seedNewsIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	liked := make([]uint, 0, len(seedNews))
+	err = config.DB.Transaction(func(tx *gorm.DB) error {
+		for _, news := range seedNews {
+			_, err := repositories.FindNewsInteraction(tx, userID, news.ID)
+			if err == nil {
+				continue // 이미 상호작용이 있으면 건드리지 않음
+			}
+			if !errors.Is(err, gorm.ErrRecordNotFound) {
+				return err
+			}
+
+			interaction := &models.NewsInteraction{
+				UserID:          userID,
+				NewsID:          news.ID,
+				InteractionType: "like",
+			}
+			if err := repositories.CreateNewsInteraction(tx, interaction); err != nil {
+				return err
+			}
+			if err := repositories.UpdateNewsCounts(tx, news.ID, 1, 0); err != nil {
+				return err
+			}
+			liked = append(liked, news.ID)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &OnboardingResultDTO{
+		Categories:   categories,
+		LikedNewsIDs: liked,
+	}, nil
+}
+
+// (헬퍼 함수) 카테고리 목록 검증
+func validateNewsCategories(categories []string) error {
+	valid := make(map[string]bool, len(NewsCategories))
+	for _, c := range NewsCategories {
+		valid[c] = true
+	}
+	for _, c := range categories {
+		if !valid[c] {
+			return errors.New("지원하지 않는 카테고리입니다: " + c)
+		}
+	}
+	return nil
+}
+
+// (헬퍼 함수) 순서를 유지하며 중복 제거
+func uniqueStrings(values []string) []string {
+	seen := make(map[string]bool, len(values))
+	result := make([]string, 0, len(values))
+	for _, v := range values {
+		if !seen[v] {
+			seen[v] = true
+			result = append(result, v)
+		}
+	}
+	return result
+}
@@ -2,9 +2,12 @@ package services
 
 import (
 	"errors"
+	"fmt"
+	"log"
 	"newsclip/backend/config"
 	"newsclip/backend/internal/app/models"
 	"newsclip/backend/internal/app/repositories"
+	"newsclip/backend/pkg/redis"
 	"time"
 
 	"gorm.io/gorm"
@@ -44,34 +47,7 @@ func GetCommunityPosts(postType string, page, size int) (*CommunityPostListRespo
 	var result []CommunityPostDTO
 
 	for _, post := range posts {
-
-		profileImage := "https://newsclip.duckdns.org/v1/images/default_profile.png"
-		if post.User.ProfileImage != nil && *post.User.ProfileImage != "" {
-			profileImage = *post.User.ProfileImage
-		}
-
-		imageURLs := make([]string, len(post.Images))
-		for i, img := range post.Images {
-			imageURLs[i] = img.ImageURL
-		}
-
-		result = append(result, CommunityPostDTO{
-			PostID:   post.ID,
-			Title:    post.Title,
-			Content:  post.Content,
-			Category: post.Category,
-			Author: AuthorDTO{
-				Nickname:     post.User.Nickname,
-				ProfileImage: profileImage,
-				Role:         post.User.Role,
-			},
-			Images:       imageURLs,
-			CreatedAt:    post.CreatedAt.Format(time.RFC3339),
-			ViewCount:    post.ViewCount,
-			LikeCount:    post.LikeCount,
-			DislikeCount: post.DislikeCount,
-			CommentCount: post.CommentCount,
-		})
+		result = append(result, toCommunityPostDTO(post))
 	}
 
 	return &CommunityPostListResponse{
@@ -79,6 +55,38 @@ func GetCommunityPosts(postType string, page, size int) (*CommunityPostListRespo
 	}, nil
 }
 
+// (헬퍼 함수) 게시글 모델을 목록/상세 공용 DTO로 변환
+// (User, Images가 Preload 되어 있어야 함)
+func toCommunityPostDTO(post models.Post) CommunityPostDTO {
+	profileImage := "https://newsclip.duckdns.org/v1/images/default_profile.png"
+	if post.User.ProfileImage != nil && *post.User.ProfileImage != "" {
+		profileImage = *post.User.ProfileImage
+	}
+
+	imageURLs := make([]string, len(post.Images))
+	for i, img := range post.Images {
+		imageURLs[i] = img.ImageURL
+	}
+
+	return CommunityPostDTO{
+		PostID:   post.ID,
+		Title:    post.Title,
+		Content:  post.Content,
+		Category: post.Category,
+		Author: AuthorDTO{
+			Nickname:     post.User.Nickname,
+			ProfileImage: profileImage,
+			Role:         post.User.Role,
+		},
+		Images:       imageURLs,
+		CreatedAt:    post.CreatedAt.Format(time.RFC3339),
+		ViewCount:    post.ViewCount,
+		LikeCount:    post.LikeCount,
+		DislikeCount: post.DislikeCount,
+		CommentCount: post.CommentCount,
+	}
+}
+
 func CreatePost(userID uint, title, content, category string, imageURLs []string) (*models.Post, error) {
 
 	post := models.Post{
@@ -102,6 +110,186 @@ func CreatePost(userID uint, title, content, category string, imageURLs []string
 	return &post, nil
 }
 
+// === 게시글 상세 조회 DTO ===
+type PostDetailDTO struct {
+	CommunityPostDTO
+	AuthorID   uint    `json:"authorId"`
+	EditedAt   *string `json:"editedAt"` // 수정된 적 없으면 null
+	IsLiked    bool    `json:"isLiked"`
+	IsDisliked bool    `json:"isDisliked"`
+}
+
+// 같은 사용자(또는 IP)의 반복 조회를 1회로 묶는 시간
+const postViewDebounce = 30 * time.Minute
+
+// === 게시글 상세 조회 서비스 ===
+// (조회수 중복 판단 기준: 로그인 유저는 userID, 비로그인은 IP)
+func GetPostDetail(postID, userID uint, clientIP string) (*PostDetailDTO, error) {
+
+	// 1. 게시글 조회 (작성자, 이미지 포함)
+	post, err := repositories.FindPostWithRelations(postID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("게시글을 찾을 수 없습니다")
+		}
+		return nil, err
+	}
+
+	// 2. 조회수 증가 (Redis로 일정 시간 내 중복 조회 방지)
+	viewerKey := "ip:" + clientIP
+	if userID != 0 {
+		viewerKey = fmt.Sprintf("user:%d", userID)
+	}
+	redisKey := fmt.Sprintf("post_view:%d:%s", postID, viewerKey)
+
+	isNewView, err := redis.SetDataIfNotExists(redisKey, 1, postViewDebounce)
+	if err != nil {
+		log.Printf("⚠️ Post view debounce failed (postID=%d): %v", postID, err)
+	} else if isNewView {
+		if err := repositories.IncrementPostViewCount(postID); err == nil {
+			post.ViewCount++
+		}
+	}
+
+	// 3. DTO 변환
+	response := &PostDetailDTO{
+		CommunityPostDTO: toCommunityPostDTO(post),
+		AuthorID:         post.UserID,
+	}
+	if post.EditedAt != nil {
+		editedAt := post.EditedAt.Format(time.RFC3339)
+		response.EditedAt = &editedAt
+	}
+
+	// 4. (로그인 유저라면) 좋아요/싫어요 상태
+	if userID != 0 {
+		interaction, err := repositories.FindPostInteraction(config.DB, userID, postID)
+		if err == nil {
+			response.IsLiked = interaction.InteractionType == "like"
+			response.IsDisliked = interaction.InteractionType == "dislike"
+		} else if !errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, err
+		}
+	}
+
+	return response, nil
+}
+
+// === 게시글 수정 입력 ===
+// (nil인 필드는 변경하지 않음)
+type UpdatePostInput struct {
+	Title         *string
+	Content       *string
+	Category      *string
+	ReplaceImages bool     // true이면 기존 이미지를 ImageURLs로 교체
+	ImageURLs     []string // ReplaceImages가 true일 때만 사용 (비어 있으면 이미지 전체 삭제)
+}
+
+// === 게시글 수정 서비스 ===
+func UpdateMyPost(userID, postID uint, input UpdatePostInput) (*PostDetailDTO, error) {
+
+	// 1. 게시글 조회
+	post, err := repositories.FindPostByID(postID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("게시글을 찾을 수 없습니다")
+		}
+		return nil, err
+	}
+
+	// 2. 작성자 검증
+	if post.UserID != userID {
+		return nil, errors.New("본인이 작성한 게시글만 수정할 수 있습니다")
+	}
+
+	// 3. 변경 필드 수집
+	updates := map[string]interface{}{}
+	if input.Title != nil && *input.Title != post.Title {
+		updates["title"] = *input.Title
+	}
+	if input.Content != nil && *input.Content != post.Content {
+		updates["content"] = *input.Content
+	}
+	if input.Category != nil && *input.Category != post.Category {
+		updates["category"] = *input.Category
+	}
+
+	if len(updates) == 0 && !input.ReplaceImages {
+		return nil, errors.New("변경할 정보가 없습니다")
+	}
+
+	updates["edited_at"] = time.Now()
+
+	// 4. 수정 이력 저장 + 게시글 갱신
+	if err := repositories.UpdatePostWithHistory(&post, updates, input.ReplaceImages, input.ImageURLs); err != nil {
+		return nil, err
+	}
+
+	// 5. 최신 상태 다시 조회하여 반환
+	updated, err := repositories.FindPostWithRelations(postID)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostDetailDTO{
+		CommunityPostDTO: toCommunityPostDTO(updated),
+		AuthorID:         updated.UserID,
+	}
+	if updated.EditedAt != nil {
+		editedAt := updated.EditedAt.Format(time.RFC3339)
+		response.EditedAt = &editedAt
+	}
+
+	return response, nil
+}
+
+// === 게시글 수정 이력 DTO ===
+type PostEditHistoryItemDTO struct {
+	Title    string `json:"title"`
+	Content  string `json:"content"`
+	Category string `json:"category"`
+	EditedAt string `json:"editedAt"` // 이 내용이 수정(대체)된 시각
+}
+
+// === 게시글 수정 이력 조회 서비스 ===
+// 수정 전 내용은 작성자가 지운 내용일 수 있으므로 작성자 본인과 관리자만 조회 가능
+func GetPostEditHistory(userID, postID uint) ([]PostEditHistoryItemDTO, error) {
+
+	// 1. 게시글 조회
+	post, err := repositories.FindPostByID(postID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("게시글을 찾을 수 없습니다")
+		}
+		return nil, err
+	}
+
+	// 2. 작성자 또는 관리자 검증
+	if post.UserID != userID {
+		user, err := repositories.FindUserByID(userID)
+		if err != nil || user.Role != "admin" {
+			return nil, errors.New("수정 이력은 작성자 또는 관리자만 조회할 수 있습니다")
+		}
+	}
+
+	histories, err := repositories.GetPostEditHistories(postID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]PostEditHistoryItemDTO, len(histories))
+	for i, h := range histories {
+		result[i] = PostEditHistoryItemDTO{
+			Title:    h.Title,
+			Content:  h.Content,
+			Category: h.Category,
+			EditedAt: h.CreatedAt.Format(time.RFC3339),
+		}
+	}
+
+	return result, nil
+}
+
 // === 게시글 상호작용 응답 DTO ===
 type PostInteractionResponseDTO struct {
 	IsLiked      bool `json:"is_liked"`
@@ -0,0 +1,197 @@
+package services
+
+import (
+	"encoding/json"
+	"log"
+	"net/url"
+	"newsclip/backend/config"
+	"newsclip/backend/internal/app/models"
+	"newsclip/backend/internal/app/repositories"
+	"os"
+	"strings"
+	"sync"
+)
+
+// 언론사 레지스트리 설정 파일 기본 경로 (PUBLISHERS_FILE 로 변경 가능)
+const defaultPublishersFile = "config/publishers.json"
+
+// === 언론사 매칭용 메모리 인덱스 ===
+type publisherIndex struct {
+	byDomain map[string]models.Publisher // 도메인 → 언론사
+	byName   map[string]models.Publisher // 소문자 이름/별칭 → 언론사
+}
+
+var (
+	publisherIndexMu sync.RWMutex
+	publishers       = publisherIndex{byDomain: map[string]models.Publisher{}, byName: map[string]models.Publisher{}}
+)
+
+// === 언론사 레지스트리 동기화 (서버 시작 시) ===
+// 1. 설정 파일의 언론사를 DB에 upsert
+// 2. DB 기준으로 메모리 인덱스 재구성
+// 3. 언론사가 매칭되지 않은 기존 뉴스를 정식 이름으로 정리
+// 4. 언론사가 연결되지 않은 기존 언론사 뮤트에 언론사 연결
+func SyncPublishers() {
+	path := config.GetEnv("PUBLISHERS_FILE")
+	if path == "" {
+		path = defaultPublishersFile
+	}
+
+	if data, err := os.ReadFile(path); err != nil {
+		log.Printf("🏢 [Publisher] No publisher file loaded (%v)", err)
+	} else {
+		var list []models.Publisher
+		if err := json.Unmarshal(data, &list); err != nil {
+			log.Printf("🔥 [Publisher] Invalid publisher file %s: %v", path, err)
+		} else if err := repositories.UpsertPublishers(list); err != nil {
+			log.Printf("🔥 [Publisher] Failed to sync publishers: %v", err)
+		}
+	}
+
+	all, err := repositories.FindAllPublishers()
+	if err != nil {
+		log.Printf("🔥 [Publisher] Failed to load publishers: %v", err)
+		return
+	}
+	rebuildPublisherIndex(all)
+
+	var backfilled, mutes int64
+	for _, p := range all {
+		keys := publisherMatchKeys(p)
+		count, err := repositories.AssignPublisherToUnmatchedNews(p, keys)
+		if err != nil {
+			log.Printf("🔥 [Publisher] Failed to backfill news for %s: %v", p.Name, err)
+		}
+		backfilled += count
+
+		count, err = repositories.AssignPublisherToSourceMutes(p, keys)
+		if err != nil {
+			log.Printf("🔥 [Publisher] Failed to backfill mutes for %s: %v", p.Name, err)
+		}
+		mutes += count
+	}
+	log.Printf("🏢 [Publisher] Loaded %d publishers (backfilled %d news, %d mutes)", len(all), backfilled, mutes)
+}
+
+// (내부 함수) 메모리 인덱스 재구성
+func rebuildPublisherIndex(all []models.Publisher) {
+	index := publisherIndex{
+		byDomain: make(map[string]models.Publisher),
+		byName:   make(map[string]models.Publisher),
+	}
+	for _, p := range all {
+		for _, domain := range p.Domains {
+			index.byDomain[normalizePublisherDomain(domain)] = p
+		}
+		index.byName[strings.ToLower(p.Name)] = p
+		for _, alias := range p.Aliases {
+			index.byName[strings.ToLower(strings.TrimSpace(alias))] = p
+		}
+	}
+
+	publisherIndexMu.Lock()
+	publishers = index
+	publisherIndexMu.Unlock()
+}
+
+// (헬퍼 함수) 기존 뉴스 정리에 사용할 Source 비교값 (소문자)
+func publisherMatchKeys(p models.Publisher) []string {
+	keys := []string{strings.ToLower(p.Name)}
+	for _, alias := range p.Aliases {
+		keys = append(keys, strings.ToLower(strings.TrimSpace(alias)))
+	}
+	for _, domain := range p.Domains {
+		domain = normalizePublisherDomain(domain)
+		keys = append(keys, domain, "www."+domain)
+	}
+	return keys
+}
+
+// (헬퍼 함수) 도메인 정규화 (소문자, www. 제거)
+func normalizePublisherDomain(domain string) string {
+	return strings.TrimPrefix(strings.ToLower(strings.TrimSpace(domain)), "www.")
+}
+
+// === 원문 링크/사이트명으로 언론사 찾기 ===
+// 1. 원문 도메인 (서브도메인이면 상위 도메인으로 올라가며 검색, 예: news.kbs.co.kr → kbs.co.kr)
+// 2. og:site_name 이 정식 이름/별칭과 일치
+func ResolvePublisher(articleURL string, siteName string) (models.Publisher, bool) {
+	publisherIndexMu.RLock()
+	defer publisherIndexMu.RUnlock()
+
+	if u, err := url.Parse(articleURL); err == nil {
+		host := normalizePublisherDomain(u.Hostname())
+		for host != "" {
+			if p, ok := publishers.byDomain[host]; ok {
+				return p, true
+			}
+			dot := strings.IndexByte(host, '.')
+			if dot < 0 {
+				break
+			}
+			host = host[dot+1:]
+		}
+	}
+
+	if siteName != "" {
+		if p, ok := publishers.byName[strings.ToLower(strings.TrimSpace(siteName))]; ok {
+			return p, true
+		}
+	}
+	return models.Publisher{}, false
+}
+
+// === 언론사 뮤트 값으로 언론사 찾기 ===
+// 뮤트 값은 정식 이름/og:site_name, 도메인("www.yna.co.kr"), 원문 링크 중 무엇이든 올 수 있음
+func ResolvePublisherByValue(value string) (models.Publisher, bool) {
+	value = strings.TrimSpace(value)
+	articleURL := value
+	if !strings.Contains(value, "://") {
+		articleURL = "https://" + value
+	}
+	return ResolvePublisher(articleURL, value)
+}
+
+// === 언론사 목록 응답 DTO ===
+type PublisherDTO struct {
+	ID              uint     `json:"id"`
+	Name            string   `json:"name"`
+	Domains         []string `json:"domains"`
+	LogoURL         string   `json:"logoUrl"`
+	ReliabilityTier int      `json:"reliabilityTier"`
+	NewsCount       int64    `json:"newsCount"`
+}
+
+// === 언론사 목록 조회 ===
+// 로고가 등록되지 않은 언론사는 대표 도메인의 파비콘을 로고로 사용
+func GetPublishers() ([]PublisherDTO, error) {
+	all, err := repositories.FindAllPublishers()
+	if err != nil {
+		return nil, err
+	}
+	counts, err := repositories.CountNewsByPublisher()
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]PublisherDTO, len(all))
+	for i, p := range all {
+		logoURL := p.LogoURL
+		if logoURL == "" && len(p.Domains) > 0 {
+			logoURL = "https://www." + normalizePublisherDomain(p.Domains[0]) + "/favicon.ico"
+		}
+		domains := p.Domains
+		if domains == nil {
+			domains = []string{}
+		}
+		result[i] = PublisherDTO{
+			ID:              p.ID,
+			Name:            p.Name,
+			Domains:         domains,
+			LogoURL:         logoURL,
+			ReliabilityTier: p.ReliabilityTier,
+			NewsCount:       counts[p.ID],
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,37 @@
+package services
+
+import (
+	"newsclip/backend/internal/app/models"
+	"testing"
+)
+
+func TestResolvePublisherByValue(t *testing.T) {
+	rebuildPublisherIndex([]models.Publisher{
+		{ID: 1, Name: "연합뉴스", Domains: []string{"yna.co.kr"}, Aliases: []string{"Yonhap News Agency"}},
+		{ID: 2, Name: "KBS", Domains: []string{"kbs.co.kr"}},
+	})
+	defer rebuildPublisherIndex(nil)
+
+	tests := []struct {
+		value  string
+		wantID uint
+		wantOK bool
+	}{
+		{"연합뉴스", 1, true},
+		{"www.yna.co.kr", 1, true},
+		{"yna.co.kr", 1, true},
+		{"Yonhap News Agency", 1, true},
+		{" yonhap news agency ", 1, true},
+		{"https://www.yna.co.kr/view/AKR2024", 1, true},
+		{"news.kbs.co.kr", 2, true},
+		{"kbs", 2, true},
+		{"모르는 언론사", 0, false},
+		{"example.com", 0, false},
+	}
+	for _, tt := range tests {
+		p, ok := ResolvePublisherByValue(tt.value)
+		if ok != tt.wantOK || p.ID != tt.wantID {
+			t.Errorf("ResolvePublisherByValue(%q) = (%d, %v), want (%d, %v)", tt.value, p.ID, ok, tt.wantID, tt.wantOK)
+		}
+	}
+}
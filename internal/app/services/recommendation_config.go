@@ -0,0 +1,104 @@
+package services
+
+import "newsclip/backend/config"
+
+// === 추천 점수 가중치 ===
+// 코드 수정 없이 .env 로 조정할 수 있도록 환경 변수에서 읽음 (없으면 기본값)
+type RecommendationWeights struct {
+	PreferredCategory float64 `json:"preferredCategory"` // P1: 선호 카테고리 가점
+	Bookmark          float64 `json:"bookmark"`          // P2: 북마크 카테고리 통계 (로그 스케일 계수)
+	Like              float64 `json:"like"`              // P3: 좋아요 카테고리 통계 (로그 스케일 계수)
+	Dislike           float64 `json:"dislike"`           // P3: 싫어요 카테고리 통계 (로그 스케일 계수, 감점)
+	ContentSimilarity float64 `json:"contentSimilarity"` // P4: 좋아요/북마크 프로필과의 콘텐츠 유사도
+	ContentDislike    float64 `json:"contentDislike"`    // P4: 싫어요 프로필과의 콘텐츠 유사도 (감점)
+	Collaborative     float64 `json:"collaborative"`     // P5: 협업 필터링 이웃 점수 (로그 스케일 계수)
+	Trending          float64 `json:"trending"`          // P6: 인기 급상승 점수 (후보 중 최고 점수 대비 비율)
+
+	Freshness          float64 `json:"freshness"`              // 신선도 최대 가점
+	FreshnessHalfLifeH float64 `json:"freshnessHalfLifeHours"` // 신선도 반감기 (시간)
+
+	MMRLambda          float64 `json:"mmrLambda"`          // MMR 관련도 비중 (1이면 다양성 미반영, 0이면 다양성만)
+	CategorySimilarity float64 `json:"categorySimilarity"` // MMR: 같은 카테고리 기사 간 유사도
+	SourceSimilarity   float64 `json:"sourceSimilarity"`   // MMR: 같은 언론사 기사 간 유사도
+}
+
+// 가중치 항목별 환경 변수 이름 / 기본값
+// (실험 변형(variant)의 params 도 같은 이름으로 가중치를 덮어씀)
+var recommendationWeightKeys = []struct {
+	Key          string
+	DefaultValue float64
+	Field        func(w *RecommendationWeights) *float64
+}{
+	{"RECO_WEIGHT_PREFERRED_CATEGORY", 30.0, func(w *RecommendationWeights) *float64 { return &w.PreferredCategory }},
+	{"RECO_WEIGHT_BOOKMARK", 5.0, func(w *RecommendationWeights) *float64 { return &w.Bookmark }},
+	{"RECO_WEIGHT_LIKE", 5.0, func(w *RecommendationWeights) *float64 { return &w.Like }},
+	{"RECO_WEIGHT_DISLIKE", 5.0, func(w *RecommendationWeights) *float64 { return &w.Dislike }},
+	{"RECO_WEIGHT_CONTENT_SIMILARITY", 25.0, func(w *RecommendationWeights) *float64 { return &w.ContentSimilarity }},
+	{"RECO_WEIGHT_CONTENT_DISLIKE", 10.0, func(w *RecommendationWeights) *float64 { return &w.ContentDislike }},
+	{"RECO_WEIGHT_COLLABORATIVE", 20.0, func(w *RecommendationWeights) *float64 { return &w.Collaborative }},
+	{"RECO_WEIGHT_TRENDING", 10.0, func(w *RecommendationWeights) *float64 { return &w.Trending }},
+	{"RECO_WEIGHT_FRESHNESS", 20.0, func(w *RecommendationWeights) *float64 { return &w.Freshness }},
+	{"RECO_FRESHNESS_HALF_LIFE_HOURS", 48.0, func(w *RecommendationWeights) *float64 { return &w.FreshnessHalfLifeH }},
+	{"RECO_MMR_LAMBDA", 0.7, func(w *RecommendationWeights) *float64 { return &w.MMRLambda }},
+	{"RECO_MMR_CATEGORY_SIMILARITY", 0.6, func(w *RecommendationWeights) *float64 { return &w.CategorySimilarity }},
+	{"RECO_MMR_SOURCE_SIMILARITY", 0.4, func(w *RecommendationWeights) *float64 { return &w.SourceSimilarity }},
+}
+
+// 환경 변수 기반 추천 가중치 로드
+func LoadRecommendationWeights() RecommendationWeights {
+	var w RecommendationWeights
+	for _, k := range recommendationWeightKeys {
+		*k.Field(&w) = config.GetEnvFloat(k.Key, k.DefaultValue)
+	}
+	return w
+}
+
+// 실험 변형 등에서 지정한 값으로 가중치 덮어쓰기 (모르는 키는 무시)
+func (w RecommendationWeights) WithOverrides(params map[string]float64) RecommendationWeights {
+	for _, k := range recommendationWeightKeys {
+		if v, ok := params[k.Key]; ok {
+			*k.Field(&w) = v
+		}
+	}
+	return w
+}
+
+// === 맞춤 쇼츠 피드(for_you) 점수 가중치 ===
+type ShortsRankingWeights struct {
+	PreferredCategory  float64 `json:"preferredCategory"`      // 선호 카테고리 가점
+	Like               float64 `json:"like"`                   // 쇼츠 좋아요 카테고리 통계 (로그 스케일 계수)
+	Dislike            float64 `json:"dislike"`                // 쇼츠 싫어요 카테고리 통계 (로그 스케일 계수, 감점)
+	Freshness          float64 `json:"freshness"`              // 신선도 최대 가점
+	FreshnessHalfLifeH float64 `json:"freshnessHalfLifeHours"` // 신선도 반감기 (시간)
+}
+
+var shortsRankingWeightKeys = []struct {
+	Key          string
+	DefaultValue float64
+	Field        func(w *ShortsRankingWeights) *float64
+}{
+	{"SHORTS_WEIGHT_PREFERRED_CATEGORY", 30.0, func(w *ShortsRankingWeights) *float64 { return &w.PreferredCategory }},
+	{"SHORTS_WEIGHT_LIKE", 5.0, func(w *ShortsRankingWeights) *float64 { return &w.Like }},
+	{"SHORTS_WEIGHT_DISLIKE", 5.0, func(w *ShortsRankingWeights) *float64 { return &w.Dislike }},
+	{"SHORTS_WEIGHT_FRESHNESS", 20.0, func(w *ShortsRankingWeights) *float64 { return &w.Freshness }},
+	{"SHORTS_FRESHNESS_HALF_LIFE_HOURS", 24.0, func(w *ShortsRankingWeights) *float64 { return &w.FreshnessHalfLifeH }},
+}
+
+// 환경 변수 기반 맞춤 쇼츠 피드 가중치 로드
+func LoadShortsRankingWeights() ShortsRankingWeights {
+	var w ShortsRankingWeights
+	for _, k := range shortsRankingWeightKeys {
+		*k.Field(&w) = config.GetEnvFloat(k.Key, k.DefaultValue)
+	}
+	return w
+}
+
+// 실험 변형 등에서 지정한 값으로 가중치 덮어쓰기 (모르는 키는 무시)
+func (w ShortsRankingWeights) WithOverrides(params map[string]float64) ShortsRankingWeights {
+	for _, k := range shortsRankingWeightKeys {
+		if v, ok := params[k.Key]; ok {
+			*k.Field(&w) = v
+		}
+	}
+	return w
+}
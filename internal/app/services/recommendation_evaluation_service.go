@@ -0,0 +1,512 @@
+package services
+
+import (
+	"errors"
+	"math"
+	"newsclip/backend/internal/app/models"
+	"newsclip/backend/internal/app/repositories"
+	"sort"
+	"time"
+)
+
+// ====================================================================
+//  오프라인 추천 평가 (과거 좋아요/북마크 기록을 시간 기준으로 나눠 재현)
+//   - 학습 구간: [SplitAt - TrainDays, SplitAt) 의 반응으로 사용자 프로필 구성
+//   - 평가 구간: [SplitAt, SplitAt + TestDays) 의 좋아요/북마크를 정답으로 사용
+//   - 후보 기사: [SplitAt - CandidateDays, SplitAt) 에 발행된 기사 (분할 시각에 추천할 수 있던 기사만)
+//   - 선호 카테고리는 변경 이력이 없어 분할 시각 기준 값을 알 수 없으므로 사용하지 않음
+// ====================================================================
+
+// === 평가 옵션 ===
+type EvaluationOptions struct {
+	SplitAt       time.Time // 학습/평가 구간 분할 시각
+	TrainDays     int       // 학습 구간 길이 (일)
+	TestDays      int       // 평가 구간 길이 (일)
+	CandidateDays int       // 분할 시각 이전 며칠 전 발행 기사까지 후보로 쓸지
+	K             int       // 추천 개수 (precision@K 등의 K)
+	Strategies    []string  // 평가할 전략 이름 (비어 있으면 등록된 전략 전체)
+}
+
+// === 평가 데이터셋 (전략이 참고할 수 있는 공용 데이터) ===
+type EvalDataset struct {
+	SplitAt    time.Time
+	Pool       []models.News             // 후보 기사 전체 (분할 시각 이전 발행)
+	Popularity map[uint]int              // 학습 구간 기사별 긍정 반응(좋아요+북마크) 수
+	Neighbors  map[uint]map[uint]float64 // 학습 구간 기준 기사 간 협업 필터링 유사도 (상위 K개)
+}
+
+// === 평가 대상 사용자 ===
+type EvalUser struct {
+	UserID              uint
+	PreferredCategories map[string]bool // 분할 시각 기준 값을 알 수 없어 항상 비어 있음 (현재 값을 쓰면 평가 구간 정보가 섞임)
+	Liked               []models.News   // 학습 구간 좋아요
+	Bookmarked          []models.News   // 학습 구간 북마크
+	Disliked            []models.News   // 학습 구간 싫어요
+	relevant            map[uint]bool   // 평가 구간 좋아요/북마크 (정답)
+	seen                map[uint]bool   // 학습 구간에 반응한 기사 (후보에서 제외)
+}
+
+// === 오프라인 추천 전략 (플러그인 형태) ===
+type OfflineRecommender interface {
+	Name() string
+	// 후보 중 상위 k개 기사를 순서대로 반환
+	Recommend(ds *EvalDataset, user *EvalUser, candidates []models.News, k int) []models.News
+}
+
+// 등록된 오프라인 추천 전략 목록 (등록 순서대로 평가)
+var offlineRecommenders []OfflineRecommender
+
+// 오프라인 평가 전략 등록
+func RegisterOfflineRecommender(r OfflineRecommender) {
+	offlineRecommenders = append(offlineRecommenders, r)
+}
+
+func init() {
+	RegisterOfflineRecommender(recencyRecommender{})
+	RegisterOfflineRecommender(popularityRecommender{})
+	RegisterOfflineRecommender(formulaRecommender{name: "category", adjust: categoryOnlyWeights})
+	RegisterOfflineRecommender(formulaRecommender{name: "content", adjust: contentOnlyWeights})
+	RegisterOfflineRecommender(formulaRecommender{name: "hybrid"}) // 현재 운영 공식 (환경 변수 가중치 + MMR)
+}
+
+// === 평가 결과 ===
+type StrategyEvaluationResult struct {
+	Strategy          string  `json:"strategy"`
+	Users             int     `json:"users"`
+	PrecisionAtK      float64 `json:"precisionAtK"`
+	RecallAtK         float64 `json:"recallAtK"`
+	NDCGAtK           float64 `json:"ndcgAtK"`
+	Coverage          float64 `json:"coverage"`          // 추천된 서로 다른 기사 수 / 후보 기사 수
+	CategoryDiversity float64 `json:"categoryDiversity"` // 추천 목록 내 서로 다른 카테고리 비율 (사용자 평균)
+}
+
+type EvaluationReport struct {
+	SplitAt   time.Time                  `json:"splitAt"`
+	TrainFrom time.Time                  `json:"trainFrom"`
+	TestTo    time.Time                  `json:"testTo"`
+	K         int                        `json:"k"`
+	PoolSize  int                        `json:"poolSize"`
+	Users     int                        `json:"users"`
+	Results   []StrategyEvaluationResult `json:"results"`
+}
+
+// === 오프라인 추천 평가 실행 ===
+func EvaluateRecommenders(opts EvaluationOptions) (*EvaluationReport, error) {
+	if opts.K <= 0 {
+		return nil, errors.New("K는 1 이상이어야 합니다")
+	}
+
+	strategies, err := selectOfflineRecommenders(opts.Strategies)
+	if err != nil {
+		return nil, err
+	}
+
+	// 1. 데이터 적재
+	ds, users, err := loadEvaluationData(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &EvaluationReport{
+		SplitAt:   opts.SplitAt,
+		TrainFrom: opts.SplitAt.AddDate(0, 0, -opts.TrainDays),
+		TestTo:    opts.SplitAt.AddDate(0, 0, opts.TestDays),
+		K:         opts.K,
+		PoolSize:  len(ds.Pool),
+		Users:     len(users),
+		Results:   make([]StrategyEvaluationResult, 0, len(strategies)),
+	}
+
+	// 2. 전략별 평가
+	for _, strategy := range strategies {
+		report.Results = append(report.Results, evaluateStrategy(strategy, ds, users, opts.K))
+	}
+
+	return report, nil
+}
+
+// (헬퍼 함수) 이름으로 평가할 전략 선택
+func selectOfflineRecommenders(names []string) ([]OfflineRecommender, error) {
+	if len(names) == 0 {
+		return offlineRecommenders, nil
+	}
+
+	byName := make(map[string]OfflineRecommender, len(offlineRecommenders))
+	for _, r := range offlineRecommenders {
+		byName[r.Name()] = r
+	}
+
+	selected := make([]OfflineRecommender, 0, len(names))
+	for _, name := range names {
+		r, ok := byName[name]
+		if !ok {
+			return nil, errors.New("알 수 없는 추천 전략입니다: " + name)
+		}
+		selected = append(selected, r)
+	}
+	return selected, nil
+}
+
+// (헬퍼 함수) 반응 기록/후보 기사를 읽어 평가 데이터셋과 평가 대상 사용자 구성
+func loadEvaluationData(opts EvaluationOptions) (*EvalDataset, []*EvalUser, error) {
+	trainFrom := opts.SplitAt.AddDate(0, 0, -opts.TrainDays)
+	testTo := opts.SplitAt.AddDate(0, 0, opts.TestDays)
+
+	// 1. 반응 기록 + 후보 기사
+	// (분할 시각 이후 발행 기사는 그 시점에 존재하지 않았으므로 후보에서 제외,
+	//  포함하면 발행 전 기사가 최신성 최고점을 받아 평가가 왜곡됨)
+	signals, err := repositories.FindUserNewsSignalsBetween(trainFrom, testTo)
+	if err != nil {
+		return nil, nil, err
+	}
+	pool, err := repositories.FindNewsPublishedBetween(opts.SplitAt.AddDate(0, 0, -opts.CandidateDays), opts.SplitAt)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// 2. 반응한 기사 본문 조회 (후보 기간 밖의 학습 기사 포함)
+	newsByID := make(map[uint]models.News, len(pool))
+	for _, n := range pool {
+		newsByID[n.ID] = n
+	}
+	var missing []uint
+	for _, sig := range signals {
+		if _, ok := newsByID[sig.NewsID]; !ok {
+			newsByID[sig.NewsID] = models.News{} // 중복 조회 방지용 자리 표시
+			missing = append(missing, sig.NewsID)
+		}
+	}
+	extra, err := repositories.FindNewsByIDs(missing)
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, n := range extra {
+		newsByID[n.ID] = n
+	}
+
+	// 3. 사용자별 학습/정답 분리
+	ds := &EvalDataset{
+		SplitAt:    opts.SplitAt,
+		Pool:       pool,
+		Popularity: make(map[uint]int),
+	}
+	usersByID := make(map[uint]*EvalUser)
+	getUser := func(userID uint) *EvalUser {
+		u, ok := usersByID[userID]
+		if !ok {
+			u = &EvalUser{UserID: userID, relevant: map[uint]bool{}, seen: map[uint]bool{}}
+			usersByID[userID] = u
+		}
+		return u
+	}
+
+	positivesByUser := make(map[uint][]uint) // 협업 필터링 계산용 (학습 구간 긍정 반응)
+	for _, sig := range signals {
+		news, ok := newsByID[sig.NewsID]
+		if !ok || news.ID == 0 { // 이미 삭제된 기사
+			continue
+		}
+		u := getUser(sig.UserID)
+		isPositive := sig.SignalType == "like" || sig.SignalType == "bookmark"
+
+		if sig.CreatedAt.Before(opts.SplitAt) {
+			u.seen[news.ID] = true
+			switch sig.SignalType {
+			case "like":
+				u.Liked = append(u.Liked, news)
+			case "bookmark":
+				u.Bookmarked = append(u.Bookmarked, news)
+			case "dislike":
+				u.Disliked = append(u.Disliked, news)
+			}
+			if isPositive {
+				ds.Popularity[news.ID]++
+				if !sig.CreatedAt.Before(opts.SplitAt.AddDate(0, 0, -newsNeighborWindowDays)) {
+					positivesByUser[sig.UserID] = append(positivesByUser[sig.UserID], news.ID)
+				}
+			}
+			continue
+		}
+
+		if isPositive {
+			u.relevant[news.ID] = true
+		}
+	}
+	ds.Neighbors = buildOfflineNeighbors(positivesByUser)
+
+	// 4. 정답이 후보에 하나라도 있는 사용자만 평가
+	inPool := make(map[uint]bool, len(pool))
+	for _, n := range pool {
+		inPool[n.ID] = true
+	}
+
+	users := make([]*EvalUser, 0, len(usersByID))
+	for _, u := range usersByID {
+		for id := range u.relevant {
+			if !inPool[id] || u.seen[id] {
+				delete(u.relevant, id)
+			}
+		}
+		if len(u.relevant) == 0 {
+			continue
+		}
+
+		u.PreferredCategories = map[string]bool{}
+		users = append(users, u)
+	}
+	sort.Slice(users, func(i, j int) bool { return users[i].UserID < users[j].UserID })
+
+	return ds, users, nil
+}
+
+// (헬퍼 함수) 학습 구간 긍정 반응으로 기사 간 유사도 계산 (RebuildNewsNeighbors 와 같은 기준)
+func buildOfflineNeighbors(positivesByUser map[uint][]uint) map[uint]map[uint]float64 {
+	itemCounts := make(map[uint]int)
+	coCounts := make(map[uint]map[uint]int)
+
+	for _, items := range positivesByUser {
+		// 같은 기사에 좋아요+북마크 한 경우 1건으로 취급
+		unique := make(map[uint]bool, len(items))
+		for _, id := range items {
+			unique[id] = true
+		}
+		for a := range unique {
+			itemCounts[a]++
+			for b := range unique {
+				if a == b {
+					continue
+				}
+				if coCounts[a] == nil {
+					coCounts[a] = make(map[uint]int)
+				}
+				coCounts[a][b]++
+			}
+		}
+	}
+
+	type neighbor struct {
+		ID    uint
+		Score float64
+	}
+
+	neighbors := make(map[uint]map[uint]float64, len(coCounts))
+	for a, row := range coCounts {
+		list := make([]neighbor, 0, len(row))
+		for b, co := range row {
+			if co < newsNeighborMinCoCount {
+				continue
+			}
+			list = append(list, neighbor{ID: b, Score: float64(co) / math.Sqrt(float64(itemCounts[a])*float64(itemCounts[b]))})
+		}
+		sort.Slice(list, func(i, j int) bool {
+			if list[i].Score == list[j].Score {
+				return list[i].ID > list[j].ID
+			}
+			return list[i].Score > list[j].Score
+		})
+		if len(list) > newsNeighborTopK {
+			list = list[:newsNeighborTopK]
+		}
+		if len(list) == 0 {
+			continue
+		}
+
+		neighbors[a] = make(map[uint]float64, len(list))
+		for _, nb := range list {
+			neighbors[a][nb.ID] = nb.Score
+		}
+	}
+	return neighbors
+}
+
+// (헬퍼 함수) 전략 하나를 전체 사용자에 대해 평가
+func evaluateStrategy(strategy OfflineRecommender, ds *EvalDataset, users []*EvalUser, k int) StrategyEvaluationResult {
+	result := StrategyEvaluationResult{Strategy: strategy.Name()}
+	recommendedItems := make(map[uint]bool)
+
+	for _, u := range users {
+		// 학습 구간에 이미 반응한 기사는 후보에서 제외 (운영 추천과 동일)
+		candidates := make([]models.News, 0, len(ds.Pool))
+		for _, n := range ds.Pool {
+			if !u.seen[n.ID] {
+				candidates = append(candidates, n)
+			}
+		}
+
+		recs := strategy.Recommend(ds, u, candidates, k)
+		if len(recs) > k {
+			recs = recs[:k]
+		}
+
+		var hits int
+		var dcg float64
+		categories := make(map[string]bool)
+		for i, n := range recs {
+			recommendedItems[n.ID] = true
+			categories[n.Category] = true
+			if u.relevant[n.ID] {
+				hits++
+				dcg += 1.0 / math.Log2(float64(i)+2.0)
+			}
+		}
+
+		var idcg float64
+		for i := 0; i < k && i < len(u.relevant); i++ {
+			idcg += 1.0 / math.Log2(float64(i)+2.0)
+		}
+
+		result.PrecisionAtK += float64(hits) / float64(k)
+		result.RecallAtK += float64(hits) / float64(len(u.relevant))
+		if idcg > 0 {
+			result.NDCGAtK += dcg / idcg
+		}
+		if len(recs) > 0 {
+			result.CategoryDiversity += float64(len(categories)) / float64(len(recs))
+		}
+		result.Users++
+	}
+
+	if result.Users > 0 {
+		n := float64(result.Users)
+		result.PrecisionAtK /= n
+		result.RecallAtK /= n
+		result.NDCGAtK /= n
+		result.CategoryDiversity /= n
+	}
+	if len(ds.Pool) > 0 {
+		result.Coverage = float64(len(recommendedItems)) / float64(len(ds.Pool))
+	}
+
+	return result
+}
+
+// ====================================================================
+//  기본 제공 전략
+// ====================================================================
+
+// 최신순 (개인화 없음, 기준선)
+type recencyRecommender struct{}
+
+func (recencyRecommender) Name() string { return "recency" }
+
+func (recencyRecommender) Recommend(ds *EvalDataset, user *EvalUser, candidates []models.News, k int) []models.News {
+	sorted := append([]models.News(nil), candidates...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].PublishedAt.After(sorted[j].PublishedAt)
+	})
+	return topNews(sorted, k)
+}
+
+// 인기순 (학습 구간 좋아요+북마크 수, 동점이면 최신순)
+type popularityRecommender struct{}
+
+func (popularityRecommender) Name() string { return "popularity" }
+
+func (popularityRecommender) Recommend(ds *EvalDataset, user *EvalUser, candidates []models.News, k int) []models.News {
+	sorted := append([]models.News(nil), candidates...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		pi, pj := ds.Popularity[sorted[i].ID], ds.Popularity[sorted[j].ID]
+		if pi == pj {
+			return sorted[i].PublishedAt.After(sorted[j].PublishedAt)
+		}
+		return pi > pj
+	})
+	return topNews(sorted, k)
+}
+
+// 운영 추천 공식(scoreWithSignals + MMR) 기반 전략
+// adjust 로 가중치 일부를 꺼서 신호별 기여를 따로 비교할 수 있음
+type formulaRecommender struct {
+	name   string
+	adjust func(RecommendationWeights) RecommendationWeights
+}
+
+func (r formulaRecommender) Name() string { return r.name }
+
+func (r formulaRecommender) Recommend(ds *EvalDataset, user *EvalUser, candidates []models.News, k int) []models.News {
+	weights := LoadRecommendationWeights()
+	if r.adjust != nil {
+		weights = r.adjust(weights)
+	}
+
+	// 1. 학습 구간 반응으로 카테고리 통계 구성
+	bookmarkCounts := countByCategory(user.Bookmarked)
+	likeCounts := countByCategory(user.Liked)
+	dislikeCounts := countByCategory(user.Disliked)
+
+	// 2. 콘텐츠 유사도 (가중치가 0이면 계산 생략)
+	contentScores := map[uint]contentScore{}
+	if weights.ContentSimilarity != 0 || weights.ContentDislike != 0 {
+		positive := append(append([]models.News(nil), user.Liked...), user.Bookmarked...)
+		contentScores = contentScoresFromProfile(candidates, positive, user.Disliked, weights)
+	}
+
+	// 3. 협업 필터링 이웃 점수 합산
+	neighborScores := make(map[uint]float64)
+	if weights.Collaborative != 0 {
+		seeds := make(map[uint]bool)
+		for _, list := range [][]models.News{user.Liked, user.Bookmarked} {
+			for _, n := range list {
+				seeds[n.ID] = true
+			}
+		}
+		for seed := range seeds {
+			for neighborID, score := range ds.Neighbors[seed] {
+				neighborScores[neighborID] += score
+			}
+		}
+	}
+
+	signals := recommendationSignals{
+		PreferredSet:   user.PreferredCategories,
+		BookmarkCounts: bookmarkCounts,
+		LikeCounts:     likeCounts,
+		DislikeCounts:  dislikeCounts,
+		ContentScores:  contentScores,
+		NeighborScores: neighborScores,
+	}
+
+	scored := scoreWithSignals(candidates, signals, weights, ds.SplitAt)
+	top := diversifyByMMR(scored, k, weights)
+
+	result := make([]models.News, len(top))
+	for i, sn := range top {
+		result[i] = sn.News
+	}
+	return result
+}
+
+// 카테고리 신호(P1~P3)만 사용
+func categoryOnlyWeights(w RecommendationWeights) RecommendationWeights {
+	w.ContentSimilarity, w.ContentDislike = 0, 0
+	w.Collaborative, w.Trending = 0, 0
+	w.Freshness = 0
+	w.MMRLambda = 1
+	return w
+}
+
+// 콘텐츠 유사도(P4)만 사용
+func contentOnlyWeights(w RecommendationWeights) RecommendationWeights {
+	w.PreferredCategory, w.Bookmark, w.Like, w.Dislike = 0, 0, 0, 0
+	w.Collaborative, w.Trending = 0, 0
+	w.Freshness = 0
+	w.MMRLambda = 1
+	return w
+}
+
+// (헬퍼 함수) 카테고리별 기사 수
+func countByCategory(newsList []models.News) map[string]int64 {
+	counts := make(map[string]int64)
+	for _, n := range newsList {
+		counts[n.Category]++
+	}
+	return counts
+}
+
+// (헬퍼 함수) 상위 k개
+func topNews(sorted []models.News, k int) []models.News {
+	if k > len(sorted) {
+		k = len(sorted)
+	}
+	return sorted[:k]
+}
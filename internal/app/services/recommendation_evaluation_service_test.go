@@ -0,0 +1,188 @@
+package services
+
+import (
+	"math"
+	"newsclip/backend/internal/app/models"
+	"reflect"
+	"testing"
+)
+
+// 정해진 순서대로 후보를 추천하는 테스트용 전략
+type fixedRecommender struct {
+	order   []uint
+	ignoreK bool // true이면 k개를 넘겨서 반환 (평가 쪽에서 잘라내는지 확인용)
+}
+
+func (fixedRecommender) Name() string { return "fixed" }
+
+func (r fixedRecommender) Recommend(ds *EvalDataset, user *EvalUser, candidates []models.News, k int) []models.News {
+	byID := make(map[uint]models.News, len(candidates))
+	for _, n := range candidates {
+		byID[n.ID] = n
+	}
+	var recs []models.News
+	for _, id := range r.order {
+		if n, ok := byID[id]; ok {
+			recs = append(recs, n)
+		}
+		if !r.ignoreK && len(recs) == k {
+			break
+		}
+	}
+	return recs
+}
+
+func idSet(ids ...uint) map[uint]bool {
+	set := make(map[uint]bool, len(ids))
+	for _, id := range ids {
+		set[id] = true
+	}
+	return set
+}
+
+func TestEvaluateStrategy(t *testing.T) {
+	ds := &EvalDataset{Pool: []models.News{
+		{ID: 1, Category: "정치"},
+		{ID: 2, Category: "경제"},
+		{ID: 3, Category: "정치"},
+		{ID: 4, Category: "사회"},
+		{ID: 5, Category: "경제"},
+	}}
+	// A: 추천 [1 2 3], 정답 1·3 → 적중 2 (1, 3위)
+	userA := &EvalUser{UserID: 1, relevant: idSet(1, 3), seen: idSet()}
+	// B: 1은 이미 본 기사라 추천 [2 3 4], 정답 4·5 → 적중 1 (3위)
+	userB := &EvalUser{UserID: 2, relevant: idSet(4, 5), seen: idSet(1)}
+
+	// 정답 2개, K=3 → IDCG = 1/log2(2) + 1/log2(3)
+	idcg := 1 + 1/math.Log2(3)
+	order := []uint{1, 2, 3, 4, 5}
+
+	tests := []struct {
+		name     string
+		strategy fixedRecommender
+		users    []*EvalUser
+		want     StrategyEvaluationResult
+	}{
+		{
+			name:     "metrics are averaged over users",
+			strategy: fixedRecommender{order: order},
+			users:    []*EvalUser{userA, userB},
+			want: StrategyEvaluationResult{
+				Users:        2,
+				PrecisionAtK: (2.0/3 + 1.0/3) / 2,
+				RecallAtK:    (1 + 0.5) / 2,
+				// A: DCG = 1/log2(2) + 1/log2(4) = 1.5, B: DCG = 1/log2(4) = 0.5
+				NDCGAtK:           (1.5/idcg + 0.5/idcg) / 2,
+				Coverage:          4.0 / 5, // 1, 2, 3, 4
+				CategoryDiversity: (2.0/3 + 3.0/3) / 2,
+			},
+		},
+		{
+			name:     "recommendations beyond k are cut",
+			strategy: fixedRecommender{order: order, ignoreK: true},
+			users:    []*EvalUser{userA},
+			want: StrategyEvaluationResult{
+				Users:             1,
+				PrecisionAtK:      2.0 / 3,
+				RecallAtK:         1,
+				NDCGAtK:           1.5 / idcg,
+				Coverage:          3.0 / 5,
+				CategoryDiversity: 2.0 / 3,
+			},
+		},
+		{
+			name:     "empty recommendation scores zero",
+			strategy: fixedRecommender{},
+			users:    []*EvalUser{userA},
+			want:     StrategyEvaluationResult{Users: 1},
+		},
+		{
+			name:     "no users",
+			strategy: fixedRecommender{order: order},
+			want:     StrategyEvaluationResult{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := evaluateStrategy(tt.strategy, ds, tt.users, 3)
+			tt.want.Strategy = "fixed"
+			if got.Strategy != tt.want.Strategy || got.Users != tt.want.Users {
+				t.Fatalf("strategy/users = %s/%d, want %s/%d", got.Strategy, got.Users, tt.want.Strategy, tt.want.Users)
+			}
+			metrics := []struct {
+				name      string
+				got, want float64
+			}{
+				{"precision@K", got.PrecisionAtK, tt.want.PrecisionAtK},
+				{"recall@K", got.RecallAtK, tt.want.RecallAtK},
+				{"NDCG@K", got.NDCGAtK, tt.want.NDCGAtK},
+				{"coverage", got.Coverage, tt.want.Coverage},
+				{"category diversity", got.CategoryDiversity, tt.want.CategoryDiversity},
+			}
+			for _, m := range metrics {
+				if math.Abs(m.got-m.want) > 1e-9 {
+					t.Errorf("%s = %v, want %v", m.name, m.got, m.want)
+				}
+			}
+		})
+	}
+}
+
+func TestBuildOfflineNeighbors(t *testing.T) {
+	tests := []struct {
+		name      string
+		positives map[uint][]uint
+		want      map[uint]map[uint]float64
+	}{
+		{
+			// 반응 사용자 수: 1·2·3 각 3명, 4는 1명
+			// 공동 반응: 1-2 3명, 1-3 2명, 2-3 2명, 3-4 1명 (최소 인원 미달)
+			name: "cosine over co-occurrence with duplicates counted once",
+			positives: map[uint][]uint{
+				1: {1, 2, 3},
+				2: {1, 2},
+				3: {1, 2, 3, 1},
+				4: {3, 4},
+			},
+			want: map[uint]map[uint]float64{
+				1: {2: 3.0 / 3, 3: 2.0 / 3},
+				2: {1: 3.0 / 3, 3: 2.0 / 3},
+				3: {1: 2.0 / 3, 2: 2.0 / 3},
+			},
+		},
+		{
+			name:      "single co-occurrence is ignored",
+			positives: map[uint][]uint{1: {1, 2}},
+			want:      map[uint]map[uint]float64{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := buildOfflineNeighbors(tt.positives)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("buildOfflineNeighbors() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBuildOfflineNeighborsTopK(t *testing.T) {
+	// 두 사용자가 같은 기사 K+2개에 반응 → 모든 점수가 1로 같으면 ID가 큰 순으로 상위 K개만 남음
+	items := make([]uint, 0, newsNeighborTopK+2)
+	for id := uint(1); id <= newsNeighborTopK+2; id++ {
+		items = append(items, id)
+	}
+	row := buildOfflineNeighbors(map[uint][]uint{1: items, 2: items})[1]
+
+	if len(row) != newsNeighborTopK {
+		t.Fatalf("len(neighbors[1]) = %d, want %d", len(row), newsNeighborTopK)
+	}
+	if _, ok := row[2]; ok {
+		t.Errorf("neighbors[1] contains 2, want it cut by top K")
+	}
+	if last := uint(newsNeighborTopK + 2); row[last] != 1 {
+		t.Errorf("neighbors[1][%d] = %v, want 1", last, row[last])
+	}
+}
@@ -4,18 +4,46 @@ import (
 	"math"
 	"newsclip/backend/internal/app/models"
 	"newsclip/backend/internal/app/repositories"
+	"newsclip/backend/pkg/textsim"
 	"sort"
 	"time"
 )
 
+// 콘텐츠 유사도 설정값
+const (
+	contentProfileLimit = 50 // 사용자 프로필 구성에 사용하는 최대 뉴스 수
+)
+
 // 개별 추천 뉴스 DTO (API 명세서 A 타입 가정)
 type RecommendedNewsItemDTO struct {
-	NewsID      uint      `json:"newsId"`
-	Title       string    `json:"title"`
-	Source      string    `json:"source"`
-	Category    string    `json:"category"`
-	ImageURL    string    `json:"imageUrl"`
-	PublishedAt time.Time `json:"publishedAt"`
+	NewsID      uint                      `json:"newsId"`
+	Title       string                    `json:"title"`
+	Source      string                    `json:"source"`
+	Category    string                    `json:"category"`
+	ImageURL    string                    `json:"imageUrl"`
+	PublishedAt time.Time                 `json:"publishedAt"`
+	Reasons     []RecommendationReasonDTO `json:"reasons,omitempty"` // [신규] 추천 이유
+}
+
+// [신규] 추천 이유 (점수에 기여한 신호)
+type RecommendationReasonDTO struct {
+	Type  string  `json:"type"`  // preferred_category, bookmark_category, like_category, content_similarity, collaborative, trending, freshness
+	Label string  `json:"label"` // 사용자에게 보여줄 문구 (예: "선호 카테고리: 기술")
+	Score float64 `json:"score"` // 해당 신호의 점수 기여도
+}
+
+// [신규] 추천 점수 구성 요소 (신호별 기여도, 감점 항목은 음수)
+type RecommendationScoreBreakdown struct {
+	PreferredCategory float64 `json:"preferredCategory"`
+	BookmarkCategory  float64 `json:"bookmarkCategory"`
+	LikeCategory      float64 `json:"likeCategory"`
+	DislikeCategory   float64 `json:"dislikeCategory"`
+	ContentSimilarity float64 `json:"contentSimilarity"`
+	ContentDislike    float64 `json:"contentDislike"`
+	Collaborative     float64 `json:"collaborative"`
+	Trending          float64 `json:"trending"`
+	Freshness         float64 `json:"freshness"`
+	Total             float64 `json:"total"`
 }
 
 // 최종 응답 DTO
@@ -23,6 +51,25 @@ type RecommendedNewsResponseDTO struct {
 	News []RecommendedNewsItemDTO `json:"news"`
 }
 
+// 점수가 매겨진 추천 후보
+type scoredNews struct {
+	News      models.News
+	Score     float64
+	Breakdown RecommendationScoreBreakdown
+}
+
+// 후보 뉴스별 콘텐츠 유사도 점수 (가중치 반영 후, Negative는 음수)
+type contentScore struct {
+	Positive float64
+	Negative float64
+}
+
+// 추천 이유로 노출할 최소 점수 / 최대 개수
+const (
+	recommendationReasonMinScore = 1.0
+	recommendationReasonMaxCount = 3
+)
+
 // 사용자 선호 기반 뉴스 추천 서비스
 func GetRecommendedNews(userID uint, size int) (*RecommendedNewsResponseDTO, error) {
 
@@ -31,6 +78,41 @@ func GetRecommendedNews(userID uint, size int) (*RecommendedNewsResponseDTO, err
 		size = 5
 	}
 
+	// 점수 가중치 (환경 변수로 조정 가능, A/B 실험 변형이 있으면 덮어씀)
+	weights := LoadRecommendationWeights()
+	variant := AssignExperimentVariant(ExperimentRecommendationRanker, userID)
+	if variant != nil {
+		weights = weights.WithOverrides(variant.Params)
+	}
+
+	// ===== 1~8. 후보 조회 및 점수 계산 =====
+	scoredList, err := scoreRecommendationCandidates(userID, size, weights)
+	if err != nil {
+		return nil, err
+	}
+
+	// ===== 9. MMR 재정렬로 상위 N(size)개 선택 (카테고리/언론사 다양화) =====
+	top := diversifyByMMR(scoredList, size, weights)
+
+	// ===== 10. DTO 변환 (A 형태 + 추천 이유) =====
+	items := make([]RecommendedNewsItemDTO, len(top))
+	newsIDs := make([]uint, len(top))
+	for i, sn := range top {
+		items[i] = toRecommendedNewsItemWithReasons(sn)
+		newsIDs[i] = sn.News.ID
+	}
+
+	// ===== 11. 실험 노출 기록 =====
+	LogExperimentExposures(ExperimentRecommendationRanker, variant, userID, "news", newsIDs)
+
+	return &RecommendedNewsResponseDTO{
+		News: items,
+	}, nil
+}
+
+// (헬퍼 함수) 추천 후보 조회 + 신호별 점수 계산 (점수 내림차순 정렬하여 반환)
+func scoreRecommendationCandidates(userID uint, size int, weights RecommendationWeights) ([]scoredNews, error) {
+
 	// ===== 1. 선호 카테고리(P1) =====
 	preferredCategories, err := repositories.GetPreferredCategories(userID)
 	if err != nil {
@@ -69,46 +151,129 @@ func GetRecommendedNews(userID uint, size int) (*RecommendedNewsResponseDTO, err
 	}
 
 	if len(candidates) == 0 {
-		return &RecommendedNewsResponseDTO{News: []RecommendedNewsItemDTO{}}, nil
+		return []scoredNews{}, nil
 	}
 
-	// ===== 5. 각 뉴스별 점수 계산 =====
-	type scoredNews struct {
-		News  models.News
-		Score float64
+	// ===== 5. 콘텐츠 유사도 (P4) =====
+	// 제목+본문 TF-IDF 벡터로 사용자 프로필(좋아요/북마크 기사)과의 코사인 유사도 계산
+	contentScores, err := computeContentScores(userID, candidates, weights)
+	if err != nil {
+		return nil, err
+	}
+
+	// ===== 6. 협업 필터링 (P5) =====
+	// "이 기사를 좋아한 사용자가 함께 좋아한 기사" 이웃 점수 합산
+	candidateIDs := make([]uint, len(candidates))
+	for i, n := range candidates {
+		candidateIDs[i] = n.ID
+	}
+	neighborScores, err := repositories.GetNeighborScoresForUser(userID, candidateIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	// ===== 6-1. 인기 급상승 (P6) =====
+	// 선호 정보가 없는 신규 사용자도 최신순이 아닌 인기 기사 위주로 추천되도록 함
+	trendingScores, err := repositories.GetTrendingScores(candidateIDs, trendingWeights)
+	if err != nil {
+		return nil, err
+	}
+
+	// ===== 7~8. 각 뉴스별 점수 계산 후 정렬 =====
+	signals := recommendationSignals{
+		PreferredSet:   preferredSet,
+		BookmarkCounts: bookmarkCounts,
+		LikeCounts:     likeCounts,
+		DislikeCounts:  dislikeCounts,
+		ContentScores:  contentScores,
+		NeighborScores: neighborScores,
+		TrendingScores: trendingScores,
+	}
+
+	return scoreWithSignals(candidates, signals, weights, time.Now()), nil
+}
+
+// 점수 계산에 필요한 사용자 신호 모음
+// (온라인 추천은 DB에서, 오프라인 평가는 과거 기록에서 채워 같은 공식으로 점수 계산)
+type recommendationSignals struct {
+	PreferredSet   map[string]bool
+	BookmarkCounts map[string]int64
+	LikeCounts     map[string]int64
+	DislikeCounts  map[string]int64
+	ContentScores  map[uint]contentScore
+	NeighborScores map[uint]float64
+	TrendingScores map[uint]float64 // 원점수 (후보 중 최고 점수로 나눠 0~1로 정규화하여 사용)
+}
+
+// (헬퍼 함수) 사용자 신호로 후보별 점수를 계산하고 점수 내림차순으로 정렬
+// now: 신선도 기준 시각
+func scoreWithSignals(candidates []models.News, signals recommendationSignals, weights RecommendationWeights, now time.Time) []scoredNews {
+	// ===== 7. 각 뉴스별 점수 계산 =====
+	var maxTrending float64
+	for _, n := range candidates {
+		if t := signals.TrendingScores[n.ID]; t > maxTrending {
+			maxTrending = t
+		}
 	}
 
 	scoredList := make([]scoredNews, 0, len(candidates))
 
 	for _, news := range candidates {
 		category := news.Category
-		var score float64
+		var b RecommendationScoreBreakdown
 
-		// --- P1: 사용자 선택 선호 카테고리 (가중치 +30) ---
-		if preferredSet[category] {
-			score += 30.0
+		// --- P1: 사용자 선택 선호 카테고리 ---
+		if signals.PreferredSet[category] {
+			b.PreferredCategory = weights.PreferredCategory
 		}
 
 		// --- P2: 북마크 기반 선호도 (로그 스케일) ---
-		if cnt, ok := bookmarkCounts[category]; ok && cnt > 0 {
-			score += 5.0 * math.Log(float64(cnt)+1.0)
+		if cnt, ok := signals.BookmarkCounts[category]; ok && cnt > 0 {
+			b.BookmarkCategory = weights.Bookmark * math.Log(float64(cnt)+1.0)
 		}
 
 		// --- P3: 좋아요/싫어요 기반 선호도 (로그 스케일) ---
-		if cnt, ok := likeCounts[category]; ok && cnt > 0 {
-			score += 5.0 * math.Log(float64(cnt)+1.0)
+		if cnt, ok := signals.LikeCounts[category]; ok && cnt > 0 {
+			b.LikeCategory = weights.Like * math.Log(float64(cnt)+1.0)
+		}
+		if cnt, ok := signals.DislikeCounts[category]; ok && cnt > 0 {
+			b.DislikeCategory = -weights.Dislike * math.Log(float64(cnt)+1.0)
+		}
+
+		// --- P4: 콘텐츠 유사도 (같은 카테고리 안에서도 순위 차이를 만듦) ---
+		b.ContentSimilarity = signals.ContentScores[news.ID].Positive
+		b.ContentDislike = signals.ContentScores[news.ID].Negative
+
+		// --- P5: 협업 필터링 이웃 점수 (로그 스케일) ---
+		if sum, ok := signals.NeighborScores[news.ID]; ok && sum > 0 {
+			b.Collaborative = weights.Collaborative * math.Log(sum+1.0)
+		}
+
+		// --- P6: 인기 급상승 (후보 중 최고 점수 대비 비율) ---
+		if maxTrending > 0 {
+			b.Trending = weights.Trending * signals.TrendingScores[news.ID] / maxTrending
 		}
-		if cnt, ok := dislikeCounts[category]; ok && cnt > 0 {
-			score -= 5.0 * math.Log(float64(cnt)+1.0)
+
+		// --- 신선도: PublishedAt 기준 지수 감쇠 (반감기마다 절반) ---
+		if weights.FreshnessHalfLifeH > 0 {
+			ageHours := now.Sub(news.PublishedAt).Hours()
+			if ageHours < 0 {
+				ageHours = 0
+			}
+			b.Freshness = weights.Freshness * math.Pow(0.5, ageHours/weights.FreshnessHalfLifeH)
 		}
 
+		b.Total = b.PreferredCategory + b.BookmarkCategory + b.LikeCategory + b.DislikeCategory +
+			b.ContentSimilarity + b.ContentDislike + b.Collaborative + b.Trending + b.Freshness
+
 		scoredList = append(scoredList, scoredNews{
-			News:  news,
-			Score: score,
+			News:      news,
+			Score:     b.Total,
+			Breakdown: b,
 		})
 	}
 
-	// ===== 6. 점수 기준 내림차순 정렬 (동점이면 최신 기사 우선) =====
+	// ===== 8. 점수 기준 내림차순 정렬 (동점이면 최신 기사 우선) =====
 	sort.Slice(scoredList, func(i, j int) bool {
 		if scoredList[i].Score == scoredList[j].Score {
 			return scoredList[i].News.PublishedAt.After(scoredList[j].News.PublishedAt)
@@ -116,27 +281,249 @@ func GetRecommendedNews(userID uint, size int) (*RecommendedNewsResponseDTO, err
 		return scoredList[i].Score > scoredList[j].Score
 	})
 
-	// 🔥 여기서 상위 N(size)개만 선택
-	if size > len(scoredList) {
-		size = len(scoredList)
+	return scoredList
+}
+
+// (헬퍼 함수) 점수가 매겨진 뉴스를 추천 이유가 포함된 DTO로 변환
+func toRecommendedNewsItemWithReasons(sn scoredNews) RecommendedNewsItemDTO {
+	n := sn.News
+	return RecommendedNewsItemDTO{
+		NewsID:      n.ID,
+		Title:       n.Title,
+		Source:      n.Source,
+		Category:    n.Category,
+		ImageURL:    n.ImageURL,
+		PublishedAt: n.PublishedAt,
+		Reasons:     buildRecommendationReasons(n, sn.Breakdown),
+	}
+}
+
+// (헬퍼 함수) 점수 구성 요소 중 기여도가 큰 가점 신호를 추천 이유로 변환 (최대 3개)
+func buildRecommendationReasons(news models.News, b RecommendationScoreBreakdown) []RecommendationReasonDTO {
+	candidates := []RecommendationReasonDTO{
+		{Type: "preferred_category", Label: "선호 카테고리: " + news.Category, Score: b.PreferredCategory},
+		{Type: "bookmark_category", Label: "자주 북마크한 카테고리: " + news.Category, Score: b.BookmarkCategory},
+		{Type: "like_category", Label: "자주 좋아요한 카테고리: " + news.Category, Score: b.LikeCategory},
+		{Type: "content_similarity", Label: "북마크/좋아요한 기사와 유사", Score: b.ContentSimilarity},
+		{Type: "collaborative", Label: "비슷한 취향의 사용자들이 좋아한 기사", Score: b.Collaborative},
+		{Type: "trending", Label: "인기 급상승", Score: b.Trending},
+		{Type: "freshness", Label: "최신 기사", Score: b.Freshness},
 	}
-	top := scoredList[:size]
 
-	// ===== 7. DTO 변환 (A 형태) =====
-	items := make([]RecommendedNewsItemDTO, len(top))
+	reasons := make([]RecommendationReasonDTO, 0, recommendationReasonMaxCount)
+	for _, r := range candidates {
+		if r.Score >= recommendationReasonMinScore {
+			r.Score = math.Round(r.Score*100) / 100
+			reasons = append(reasons, r)
+		}
+	}
+
+	sort.SliceStable(reasons, func(i, j int) bool {
+		return reasons[i].Score > reasons[j].Score
+	})
+	if len(reasons) > recommendationReasonMaxCount {
+		reasons = reasons[:recommendationReasonMaxCount]
+	}
+	return reasons
+}
+
+// (헬퍼 함수) 후보 뉴스별 콘텐츠 유사도 점수 계산
+//   - 후보 + 프로필 기사를 하나의 말뭉치로 보고 TF-IDF 벡터 생성 (외부 API 없이 서버 내에서 계산)
+//   - 좋아요/북마크 기사 중심 벡터와의 유사도는 가점, 싫어요 기사 중심 벡터와의 유사도는 감점
+//   - 프로필 기사가 없으면 빈 맵 반환 (기존 카테고리 점수만 사용)
+func computeContentScores(userID uint, candidates []models.News, weights RecommendationWeights) (map[uint]contentScore, error) {
+	positive, negative, err := repositories.FindNewsForContentProfile(userID, contentProfileLimit)
+	if err != nil {
+		return nil, err
+	}
+	return contentScoresFromProfile(candidates, positive, negative, weights), nil
+}
+
+// (헬퍼 함수) 좋아요/북마크(positive)·싫어요(negative) 기사 목록으로 후보별 콘텐츠 유사도 점수 계산
+func contentScoresFromProfile(candidates, positive, negative []models.News, weights RecommendationWeights) map[uint]contentScore {
+	scores := make(map[uint]contentScore, len(candidates))
+	if len(positive) == 0 && len(negative) == 0 {
+		return scores
+	}
+
+	// 1. 말뭉치 구성: [후보..., 긍정 프로필..., 부정 프로필...]
+	docs := make([]string, 0, len(candidates)+len(positive)+len(negative))
+	for _, list := range [][]models.News{candidates, positive, negative} {
+		for _, n := range list {
+			docs = append(docs, n.Title+" "+n.Content)
+		}
+	}
+	vectors := textsim.BuildTFIDF(docs)
+
+	candidateVecs := vectors[:len(candidates)]
+	positiveVecs := vectors[len(candidates) : len(candidates)+len(positive)]
+	negativeVecs := vectors[len(candidates)+len(positive):]
+
+	// 2. 사용자 프로필 벡터
+	var positiveProfile, negativeProfile textsim.Vector
+	if len(positiveVecs) > 0 {
+		positiveProfile = textsim.Centroid(positiveVecs)
+	}
+	if len(negativeVecs) > 0 {
+		negativeProfile = textsim.Centroid(negativeVecs)
+	}
+
+	// 3. 후보별 점수
+	for i, news := range candidates {
+		var score contentScore
+		if positiveProfile != nil {
+			score.Positive = weights.ContentSimilarity * textsim.Cosine(candidateVecs[i], positiveProfile)
+		}
+		if negativeProfile != nil {
+			score.Negative = -weights.ContentDislike * textsim.Cosine(candidateVecs[i], negativeProfile)
+		}
+		scores[news.ID] = score
+	}
+
+	return scores
+}
+
+// (헬퍼 함수) MMR(Maximal Marginal Relevance) 재정렬
+// 점수 내림차순으로 정렬된 목록에서, 매번
+//
+//	λ * 정규화 점수 - (1-λ) * 이미 고른 기사들과의 최대 유사도
+//
+// 가 가장 큰 기사를 하나씩 골라 같은 카테고리/언론사 기사가 몰리지 않도록 함
+func diversifyByMMR(sorted []scoredNews, size int, weights RecommendationWeights) []scoredNews {
+	if size > len(sorted) {
+		size = len(sorted)
+	}
+	if size == 0 {
+		return []scoredNews{}
+	}
+
+	// 1. 점수를 [0, 1]로 정규화 (유사도와 같은 척도로 비교하기 위함)
+	maxScore, minScore := sorted[0].Score, sorted[len(sorted)-1].Score
+	relevance := make([]float64, len(sorted))
+	for i, sn := range sorted {
+		if maxScore > minScore {
+			relevance[i] = (sn.Score - minScore) / (maxScore - minScore)
+		} else {
+			relevance[i] = 1
+		}
+	}
+
+	// 2. 두 기사 간 유사도: 같은 카테고리/언론사 여부의 가중합
+	similarity := func(a, b models.News) float64 {
+		var sim float64
+		if a.Category != "" && a.Category == b.Category {
+			sim += weights.CategorySimilarity
+		}
+		if a.Source != "" && a.Source == b.Source {
+			sim += weights.SourceSimilarity
+		}
+		return sim
+	}
+
+	// 3. 탐욕적 선택
+	lambda := weights.MMRLambda
+	selected := make([]scoredNews, 0, size)
+	used := make([]bool, len(sorted))
+
+	for len(selected) < size {
+		bestIdx := -1
+		bestValue := math.Inf(-1)
+
+		for i, sn := range sorted {
+			if used[i] {
+				continue
+			}
+
+			var maxSim float64
+			for _, picked := range selected {
+				if sim := similarity(sn.News, picked.News); sim > maxSim {
+					maxSim = sim
+				}
+			}
+
+			// 동점이면 앞쪽(원래 점수가 높은) 기사 우선
+			value := lambda*relevance[i] - (1-lambda)*maxSim
+			if value > bestValue {
+				bestValue = value
+				bestIdx = i
+			}
+		}
+
+		used[bestIdx] = true
+		selected = append(selected, sorted[bestIdx])
+	}
+
+	return selected
+}
+
+// === [관리자/디버그] 추천 점수 상세 DTO ===
+type RecommendationCandidateDebugDTO struct {
+	NewsID       uint                         `json:"newsId"`
+	Title        string                       `json:"title"`
+	Source       string                       `json:"source"`
+	Category     string                       `json:"category"`
+	PublishedAt  time.Time                    `json:"publishedAt"`
+	Breakdown    RecommendationScoreBreakdown `json:"breakdown"`
+	ScoreRank    int                          `json:"scoreRank"`    // 점수순 순위 (1부터)
+	SelectedRank int                          `json:"selectedRank"` // MMR 재정렬 후 노출 순위 (선택되지 않았으면 0)
+}
+
+type RecommendationDebugDTO struct {
+	UserID      uint                              `json:"userId"`
+	Variant     string                            `json:"variant,omitempty"` // 할당된 A/B 실험 변형
+	Weights     RecommendationWeights             `json:"weights"`
+	Recommended []RecommendedNewsItemDTO          `json:"recommended"`
+	Candidates  []RecommendationCandidateDebugDTO `json:"candidates"`
+}
+
+// === [관리자/디버그] 특정 사용자의 추천 후보 전체 점수 분해 조회 ===
+func GetRecommendationDebug(userID uint, size int) (*RecommendationDebugDTO, error) {
+	if size <= 0 {
+		size = 5
+	}
+
+	weights := LoadRecommendationWeights()
+	variantName := ""
+	if variant := AssignExperimentVariant(ExperimentRecommendationRanker, userID); variant != nil {
+		weights = weights.WithOverrides(variant.Params)
+		variantName = variant.Name
+	}
+
+	scoredList, err := scoreRecommendationCandidates(userID, size, weights)
+	if err != nil {
+		return nil, err
+	}
+	top := diversifyByMMR(scoredList, size, weights)
+
+	// 1. 최종 노출 순위 매핑
+	selectedRank := make(map[uint]int, len(top))
+	recommended := make([]RecommendedNewsItemDTO, len(top))
 	for i, sn := range top {
+		selectedRank[sn.News.ID] = i + 1
+		recommended[i] = toRecommendedNewsItemWithReasons(sn)
+	}
+
+	// 2. 후보 전체 점수 분해 (점수순)
+	candidates := make([]RecommendationCandidateDebugDTO, len(scoredList))
+	for i, sn := range scoredList {
 		n := sn.News
-		items[i] = RecommendedNewsItemDTO{
-			NewsID:      n.ID,
-			Title:       n.Title,
-			Source:      n.Source,
-			Category:    n.Category,
-			ImageURL:    n.ImageURL,
-			PublishedAt: n.PublishedAt,
+		candidates[i] = RecommendationCandidateDebugDTO{
+			NewsID:       n.ID,
+			Title:        n.Title,
+			Source:       n.Source,
+			Category:     n.Category,
+			PublishedAt:  n.PublishedAt,
+			Breakdown:    sn.Breakdown,
+			ScoreRank:    i + 1,
+			SelectedRank: selectedRank[n.ID],
 		}
 	}
 
-	return &RecommendedNewsResponseDTO{
-		News: items,
+	return &RecommendationDebugDTO{
+		UserID:      userID,
+		Variant:     variantName,
+		Weights:     weights,
+		Recommended: recommended,
+		Candidates:  candidates,
 	}, nil
 }
@@ -0,0 +1,218 @@
+package services
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"newsclip/backend/internal/app/models"
+	"newsclip/backend/internal/app/repositories"
+	"newsclip/backend/pkg/redis"
+	"sort"
+	"time"
+)
+
+// 맞춤 쇼츠 피드 설정값
+const (
+	shortsForYouCandidateDays  = 7                // 후보: 최근 7일 이내 쇼츠
+	shortsForYouCandidateLimit = 300              // 후보 최대 개수
+	shortsForYouSnapshotTTL    = 30 * time.Minute // 랭킹 스냅샷 유지 시간
+)
+
+// 맞춤 피드 다음 페이지 요청 시 랭킹 스냅샷이 없거나 커서가 스냅샷에 없음
+// (클라이언트는 cursorId 없이 첫 페이지부터 다시 요청)
+var ErrShortsFeedExpired = errors.New("맞춤 피드가 만료되었습니다")
+
+// 맞춤 피드 랭킹 스냅샷 키
+// (첫 페이지 요청 시 전체 순위를 저장해두고, 다음 페이지는 같은 순위에서 이어서 반환)
+func shortsForYouSnapshotKey(userID uint) string {
+	return fmt.Sprintf("shorts_for_you:%d", userID)
+}
+
+// === 맞춤 쇼츠 피드 조회 서비스 (mode=for_you) ===
+// cursorID: 직전 페이지의 마지막 쇼츠 ID (0이면 첫 페이지 → 랭킹 새로 계산)
+func GetPersonalizedShortsFeed(size int, cursorID uint, userID uint) ([]ShortFeedItemDTO, error) {
+
+	// 비로그인 사용자는 개인화 정보가 없으므로 최신순 피드로 대체
+	if userID == 0 {
+		return GetShortsFeed(size, cursorID, userID)
+	}
+
+	// 점수 가중치 (환경 변수로 조정 가능, A/B 실험 변형이 있으면 덮어씀)
+	weights := LoadShortsRankingWeights()
+	variant := AssignExperimentVariant(ExperimentShortsRanker, userID)
+	if variant != nil {
+		weights = weights.WithOverrides(variant.Params)
+	}
+
+	// 1. 랭킹 스냅샷 준비 (첫 페이지면 새로 계산, 다음 페이지는 저장된 스냅샷 사용)
+	var rankedIDs []uint
+	if cursorID > 0 {
+		rankedIDs = loadShortsForYouSnapshot(userID)
+		// [수정] 스냅샷이 만료되었으면 다시 계산하지 않고 피드 초기화 신호 반환
+		// (새 순위에서는 커서 위치를 알 수 없어 첫 페이지가 중복으로 내려가기 때문)
+		if rankedIDs == nil {
+			return nil, ErrShortsFeedExpired
+		}
+	} else {
+		var err error
+		rankedIDs, err = rankShortsForUser(userID, weights)
+		if err != nil {
+			return nil, err
+		}
+		saveShortsForYouSnapshot(userID, rankedIDs)
+	}
+
+	// 2. 커서 위치 찾기 (커서가 스냅샷에 없으면 피드 초기화 신호)
+	start := 0
+	if cursorID > 0 {
+		start = -1
+		for i, id := range rankedIDs {
+			if id == cursorID {
+				start = i + 1
+				break
+			}
+		}
+		if start < 0 {
+			return nil, ErrShortsFeedExpired
+		}
+	}
+	if start >= len(rankedIDs) {
+		return []ShortFeedItemDTO{}, nil
+	}
+
+	end := start + size
+	if end > len(rankedIDs) {
+		end = len(rankedIDs)
+	}
+	pageIDs := rankedIDs[start:end]
+
+	// 3. 페이지에 해당하는 쇼츠 조회 후 랭킹 순서대로 정렬
+	shorts, err := repositories.FindShortsByIDs(pageIDs)
+	if err != nil {
+		return nil, err
+	}
+	shortMap := make(map[uint]models.Short, len(shorts))
+	for _, s := range shorts {
+		shortMap[s.ID] = s
+	}
+
+	ordered := make([]models.Short, 0, len(pageIDs))
+	orderedIDs := make([]uint, 0, len(pageIDs))
+	for _, id := range pageIDs {
+		if s, ok := shortMap[id]; ok { // 그 사이 삭제된 쇼츠는 건너뜀
+			ordered = append(ordered, s)
+			orderedIDs = append(orderedIDs, id)
+		}
+	}
+
+	// 4. 실험 노출 기록
+	LogExperimentExposures(ExperimentShortsRanker, variant, userID, "short", orderedIDs)
+
+	return buildShortFeedItems(ordered, userID), nil
+}
+
+// 사용자 맞춤 순서로 정렬된 쇼츠 ID 목록 계산
+func rankShortsForUser(userID uint, weights ShortsRankingWeights) ([]uint, error) {
+
+	// ===== 1. 선호 카테고리(P1) =====
+	preferredCategories, err := repositories.GetPreferredCategories(userID)
+	if err != nil {
+		return nil, err
+	}
+	preferredSet := make(map[string]bool, len(preferredCategories))
+	for _, c := range preferredCategories {
+		preferredSet[c] = true
+	}
+
+	// ===== 2. 쇼츠 좋아요/싫어요 기반 카테고리 통계 =====
+	likeCounts, dislikeCounts, err := repositories.GetShortInteractionCategoryStats(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	// ===== 3. 후보 쇼츠 조회 (이미 본 쇼츠 제외) =====
+	candidates, err := repositories.FindShortCandidatesForUser(userID, shortsForYouCandidateDays, shortsForYouCandidateLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	// ===== 4. 각 쇼츠별 점수 계산 =====
+	type scoredShort struct {
+		Short models.Short
+		Score float64
+	}
+
+	now := time.Now()
+	scoredList := make([]scoredShort, 0, len(candidates))
+
+	for _, short := range candidates {
+		category := short.News.Category
+		var score float64
+
+		// --- P1: 사용자 선택 선호 카테고리 ---
+		if preferredSet[category] {
+			score += weights.PreferredCategory
+		}
+
+		// --- 쇼츠 좋아요/싫어요 기반 선호도 (로그 스케일) ---
+		if cnt, ok := likeCounts[category]; ok && cnt > 0 {
+			score += weights.Like * math.Log(float64(cnt)+1.0)
+		}
+		if cnt, ok := dislikeCounts[category]; ok && cnt > 0 {
+			score -= weights.Dislike * math.Log(float64(cnt)+1.0)
+		}
+
+		// --- 신선도: 반감기마다 절반으로 감소 ---
+		if weights.FreshnessHalfLifeH > 0 {
+			ageHours := now.Sub(short.CreatedAt).Hours()
+			if ageHours < 0 {
+				ageHours = 0
+			}
+			score += weights.Freshness * math.Pow(0.5, ageHours/weights.FreshnessHalfLifeH)
+		}
+
+		scoredList = append(scoredList, scoredShort{
+			Short: short,
+			Score: score,
+		})
+	}
+
+	// ===== 5. 점수 기준 내림차순 정렬 (동점이면 최신 쇼츠 우선) =====
+	sort.Slice(scoredList, func(i, j int) bool {
+		if scoredList[i].Score == scoredList[j].Score {
+			return scoredList[i].Short.ID > scoredList[j].Short.ID
+		}
+		return scoredList[i].Score > scoredList[j].Score
+	})
+
+	rankedIDs := make([]uint, len(scoredList))
+	for i, ss := range scoredList {
+		rankedIDs[i] = ss.Short.ID
+	}
+
+	return rankedIDs, nil
+}
+
+// 랭킹 스냅샷 저장 (실패해도 피드 응답에는 영향 없음)
+func saveShortsForYouSnapshot(userID uint, rankedIDs []uint) {
+	data, err := json.Marshal(rankedIDs)
+	if err != nil {
+		return
+	}
+	_ = redis.SetData(shortsForYouSnapshotKey(userID), string(data), shortsForYouSnapshotTTL)
+}
+
+// 랭킹 스냅샷 조회 (없거나 만료되었으면 nil)
+func loadShortsForYouSnapshot(userID uint) []uint {
+	raw, err := redis.GetData(shortsForYouSnapshotKey(userID))
+	if err != nil {
+		return nil
+	}
+
+	var rankedIDs []uint
+	if err := json.Unmarshal([]byte(raw), &rankedIDs); err != nil {
+		return nil
+	}
+	return rankedIDs
+}
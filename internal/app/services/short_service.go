@@ -3,65 +3,15 @@ package services
 import (
 	"errors"
 	"log"
-	"net/http"
 	"newsclip/backend/config"
 	"newsclip/backend/internal/app/models"
 	"newsclip/backend/internal/app/repositories"
 	"newsclip/backend/pkg/openai"
-	"strings"
 	"time"
 
-	"github.com/PuerkitoBio/goquery"
 	"gorm.io/gorm"
 )
 
-// === [내부 함수] 뉴스 본문 크롤링 ===
-// === 뉴스 본문 크롤링 (네이버 뉴스 #dic_area 구조 대응) ===
-func crawlNewsContent(url string) (string, error) {
-	res, err := http.Get(url)
-	if err != nil {
-		return "", err
-	}
-	defer res.Body.Close()
-
-	doc, err := goquery.NewDocumentFromReader(res.Body)
-	if err != nil {
-		return "", err
-	}
-
-	// 1. 네이버 뉴스의 본문 영역 ID (#dic_area) 선택
-	selection := doc.Find("#dic_area")
-
-	// 만약 #dic_area가 없으면 상위 클래스나 일반적인 구조로 폴백(Fallback)
-	if selection.Length() == 0 {
-		selection = doc.Find("#newsct_article")
-	}
-	// 그래도 없으면 일반적인 article 태그 시도
-	if selection.Length() == 0 {
-		selection = doc.Find("article")
-	}
-
-	// 2. 불필요한 요소 제거 (이미지 캡션, 사진 영역 등)
-	// 제공해주신 HTML의 <span class="end_photo_org"> 제거
-	selection.Find(".end_photo_org").Remove()
-	selection.Find(".img_desc").Remove() // 일반적인 이미지 설명 제거
-	selection.Find("img").Remove()       // 이미지 태그 제거
-	selection.Find("script").Remove()    // 스크립트 제거
-	selection.Find("iframe").Remove()    // 동영상 등 제거
-
-	// 3. <br> 태그를 공백으로 치환
-	// (그냥 .Text()를 하면 "안녕하세요<br>반갑습니다"가 "안녕하세요반갑습니다"로 붙어버림)
-	selection.Find("br").ReplaceWithHtml(" ")
-
-	// 4. 텍스트 추출 및 공백 정리
-	text := selection.Text()
-
-	// strings.Fields는 연속된 공백(스페이스, 탭, 줄바꿈)을 하나로 합쳐줍니다.
-	cleanText := strings.Join(strings.Fields(text), " ")
-
-	return cleanText, nil
-}
-
 // === [핵심] 쇼츠 생성 및 저장 서비스 (카테고리별 1개) ===
 func GenerateShorts() error {
 	log.Println("🤖 [Shorts Generator] Starting to generate shorts per category...")
@@ -104,8 +54,8 @@ func GenerateShorts() error {
 
 		// 3. 후보 뉴스 중 하나를 성공할 때까지 시도
 		for _, news := range candidates {
-			// A. 본문 크롤링
-			fullContent, err := crawlNewsContent(news.URL)
+			// A. 본문 크롤링 (이미 저장된 본문이 있으면 재사용)
+			fullContent, err := GetNewsFullContent(&news)
 			if err != nil || len(fullContent) < 100 {
 				log.Printf("   Skipping NewsID %d (%s): Crawl failed or too short.", news.ID, category)
 				continue
@@ -151,7 +101,8 @@ type ShortFeedItemDTO struct {
 	ImageURL       string `json:"imageUrl"`
 	LikeCount      int    `json:"likeCount"`
 	DislikeCount   int    `json:"dislikeCount"`
-	CommentCount   int    `json:"commentCount"` // (Comment 테이블 Count 로직은 생략, 현재 0)
+	CommentCount   int    `json:"commentCount"`
+	ViewCount      int    `json:"viewCount"`
 	IsLiked        bool   `json:"isLiked"`
 	IsDisliked     bool   `json:"isDisliked"`
 }
@@ -160,7 +111,7 @@ type ShortFeedItemDTO struct {
 func GetShortsFeed(size int, cursorID uint, userID uint) ([]ShortFeedItemDTO, error) {
 
 	// 1. 레포지토리 호출 (cursorID 전달)
-	shorts, err := repositories.FindRecentShorts(size, cursorID)
+	shorts, err := repositories.FindRecentShorts(size, cursorID, userID)
 	if err != nil {
 		return nil, err
 	}
@@ -169,7 +120,16 @@ func GetShortsFeed(size int, cursorID uint, userID uint) ([]ShortFeedItemDTO, er
 		return []ShortFeedItemDTO{}, nil
 	}
 
-	// 2. (로그인 유저라면) 상호작용 정보 가져오기
+	return buildShortFeedItems(shorts, userID), nil
+}
+
+// (헬퍼 함수) 쇼츠 목록을 피드 DTO로 변환 (로그인 유저라면 상호작용 상태 포함)
+func buildShortFeedItems(shorts []models.Short, userID uint) []ShortFeedItemDTO {
+	if len(shorts) == 0 {
+		return []ShortFeedItemDTO{}
+	}
+
+	// 1. (로그인 유저라면) 상호작용 정보 가져오기
 	shortIDs := make([]uint, len(shorts))
 	for i, s := range shorts {
 		shortIDs[i] = s.ID
@@ -185,7 +145,7 @@ func GetShortsFeed(size int, cursorID uint, userID uint) ([]ShortFeedItemDTO, er
 		}
 	}
 
-	// 3. DTO 변환
+	// 2. DTO 변환
 	feed := make([]ShortFeedItemDTO, len(shorts))
 	for i, s := range shorts {
 		interType, exists := interactionMap[s.ID]
@@ -198,12 +158,82 @@ func GetShortsFeed(size int, cursorID uint, userID uint) ([]ShortFeedItemDTO, er
 			ImageURL:       s.ImageURL,
 			LikeCount:      s.LikeCount,
 			DislikeCount:   s.DislikeCount,
+			CommentCount:   s.CommentCount,
+			ViewCount:      s.ViewCount,
 			IsLiked:        exists && interType == "like",
 			IsDisliked:     exists && interType == "dislike",
 		}
 	}
 
-	return feed, nil
+	return feed
+}
+
+// === 쇼츠 상세 응답 DTO ===
+type ShortOriginalNewsDTO struct {
+	NewsID      uint      `json:"newsId"`
+	Title       string    `json:"title"`
+	Source      string    `json:"source"`
+	URL         string    `json:"url"`
+	Category    string    `json:"category"`
+	ImageURL    string    `json:"imageUrl"`
+	PublishedAt time.Time `json:"publishedAt"`
+}
+
+type ShortDetailDTO struct {
+	ShortFeedItemDTO
+	CreatedAt    time.Time            `json:"createdAt"`
+	OriginalNews ShortOriginalNewsDTO `json:"originalNews"`
+}
+
+// === 쇼츠 상세 조회 서비스 ===
+func GetShortDetail(shortID uint, userID uint) (*ShortDetailDTO, error) {
+
+	// 1. 쇼츠 + 원본 뉴스 조회
+	short, err := repositories.FindShortWithNews(shortID)
+	if err != nil {
+		return nil, err
+	}
+
+	// 2. (로그인 유저라면) 상호작용 정보 가져오기
+	interType := ""
+	if userID != 0 {
+		interaction, err := repositories.FindShortInteraction(config.DB, userID, shortID)
+		if err == nil {
+			interType = interaction.InteractionType
+		} else if !errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, err
+		}
+	}
+
+	// 3. DTO 변환
+	news := short.News
+	response := &ShortDetailDTO{
+		ShortFeedItemDTO: ShortFeedItemDTO{
+			ShortID:        short.ID,
+			OriginalNewsID: short.NewsID,
+			Title:          short.Title,
+			Summary:        short.Summary,
+			ImageURL:       short.ImageURL,
+			LikeCount:      short.LikeCount,
+			DislikeCount:   short.DislikeCount,
+			CommentCount:   short.CommentCount,
+			ViewCount:      short.ViewCount,
+			IsLiked:        interType == "like",
+			IsDisliked:     interType == "dislike",
+		},
+		CreatedAt: short.CreatedAt,
+		OriginalNews: ShortOriginalNewsDTO{
+			NewsID:      news.ID,
+			Title:       news.Title,
+			Source:      news.Source,
+			URL:         news.URL,
+			Category:    news.Category,
+			ImageURL:    news.ImageURL,
+			PublishedAt: news.PublishedAt,
+		},
+	}
+
+	return response, nil
 }
 
 // === 쇼츠 상호작용 서비스 ===
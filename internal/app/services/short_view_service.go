@@ -0,0 +1,149 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"newsclip/backend/internal/app/repositories"
+	"newsclip/backend/pkg/redis"
+	"time"
+)
+
+// 쇼츠 시청 이벤트 설정값
+const (
+	shortViewMaxBatchSize = 100            // 요청 1건당 최대 이벤트 수
+	shortViewMaxDwellMs   = 10 * 60 * 1000 // 이벤트 1건당 인정하는 최대 시청 시간 (10분)
+)
+
+// 같은 사용자(또는 IP)의 같은 쇼츠 시청을 쇼츠 카운터에 1회로 묶는 시간
+const shortViewDebounce = 30 * time.Minute
+
+// === 쇼츠 시청 이벤트 (클라이언트 → 서버) ===
+type ShortViewEvent struct {
+	ShortID   uint  `json:"short_id"`
+	DwellMs   int64 `json:"dwell_ms"`  // 화면에 머문 시간 (ms)
+	Completed bool  `json:"completed"` // 끝까지 시청했는지
+	Skipped   bool  `json:"skipped"`   // 넘겨버렸는지
+}
+
+// === 시청 이벤트 수집 결과 DTO ===
+type ShortViewEventsResultDTO struct {
+	Accepted int `json:"accepted"` // 반영된 이벤트 수
+	Ignored  int `json:"ignored"`  // 존재하지 않는 쇼츠 등으로 무시된 이벤트 수
+}
+
+// === 쇼츠 시청 이벤트 일괄 수집 서비스 ===
+// userID가 0(비로그인)이면 쇼츠 카운터만 집계하고 시청 기록은 남기지 않음
+// (쇼츠 카운터는 게시글 조회수처럼 사용자(비로그인은 IP)·쇼츠별로 일정 시간 내 1회만 반영)
+func RecordShortViewEvents(userID uint, clientIP string, events []ShortViewEvent) (*ShortViewEventsResultDTO, error) {
+
+	// 1. 요청 검증
+	if len(events) == 0 {
+		return nil, errors.New("이벤트가 비어 있습니다")
+	}
+	if len(events) > shortViewMaxBatchSize {
+		return nil, errors.New("한 번에 보낼 수 있는 이벤트 수를 초과했습니다")
+	}
+
+	// 2. 쇼츠별로 집계 (같은 쇼츠 이벤트는 하나로 합침, 입력 순서 유지)
+	statsMap := make(map[uint]*repositories.ShortViewStats)
+	var order []uint
+	for _, ev := range events {
+		if ev.ShortID == 0 {
+			continue
+		}
+
+		dwell := ev.DwellMs
+		if dwell < 0 {
+			dwell = 0
+		}
+		if dwell > shortViewMaxDwellMs {
+			dwell = shortViewMaxDwellMs
+		}
+
+		st, ok := statsMap[ev.ShortID]
+		if !ok {
+			st = &repositories.ShortViewStats{ShortID: ev.ShortID}
+			statsMap[ev.ShortID] = st
+			order = append(order, ev.ShortID)
+		}
+		st.Views++
+		st.DwellMs += dwell
+		if ev.Completed {
+			st.Completions++
+		}
+		if ev.Skipped {
+			st.Skips++
+		}
+	}
+
+	// 3. 실제로 존재하는 쇼츠만 반영
+	shorts, err := repositories.FindShortsByIDs(order)
+	if err != nil {
+		return nil, err
+	}
+	exists := make(map[uint]bool, len(shorts))
+	for _, s := range shorts {
+		exists[s.ID] = true
+	}
+
+	// 4. 중복 시청 제외 (Redis로 일정 시간 내 같은 쇼츠는 카운터에 1회만 반영)
+	viewerKey := "ip:" + clientIP
+	if userID != 0 {
+		viewerKey = fmt.Sprintf("user:%d", userID)
+	}
+
+	stats := make([]repositories.ShortViewStats, 0, len(order))
+	viewedIDs := make([]uint, 0, len(order))
+	accepted := 0
+	for _, id := range order {
+		if !exists[id] {
+			continue
+		}
+		st := *statsMap[id]
+		accepted += st.Views
+		viewedIDs = append(viewedIDs, id)
+
+		redisKey := fmt.Sprintf("short_view:%d:%s", id, viewerKey)
+		isNewView, err := redis.SetDataIfNotExists(redisKey, 1, shortViewDebounce)
+		if err != nil {
+			log.Printf("⚠️ Short view debounce failed (shortID=%d): %v", id, err)
+		}
+		st.Counted = err == nil && isNewView
+		if st.Counted {
+			st.Counters = countedShortView(st)
+		} else if userID == 0 {
+			continue // 비로그인 중복 시청은 반영할 곳이 없음
+		}
+		stats = append(stats, st)
+	}
+
+	if len(stats) > 0 {
+		if err := repositories.ApplyShortViewStats(userID, stats); err != nil {
+			return nil, err
+		}
+	}
+
+	// 5. A/B 실험 노출 쇼츠였다면 시청(클릭)으로 기록
+	MarkExperimentClicks(userID, "short", viewedIDs)
+
+	return &ShortViewEventsResultDTO{
+		Accepted: accepted,
+		Ignored:  len(events) - accepted,
+	}, nil
+}
+
+// (헬퍼 함수) 쇼츠 카운터에 반영할 시청 1회분 (시청 1회, 시청 시간 최대 10분, 완주/스킵 최대 1회)
+func countedShortView(st repositories.ShortViewStats) repositories.ShortViewCounters {
+	counters := repositories.ShortViewCounters{Views: 1, DwellMs: st.DwellMs}
+	if counters.DwellMs > shortViewMaxDwellMs {
+		counters.DwellMs = shortViewMaxDwellMs
+	}
+	if st.Completions > 0 {
+		counters.Completions = 1
+	}
+	if st.Skips > 0 {
+		counters.Skips = 1
+	}
+	return counters
+}
@@ -0,0 +1,43 @@
+package services
+
+import (
+	"newsclip/backend/internal/app/repositories"
+	"testing"
+)
+
+func TestCountedShortView(t *testing.T) {
+	tests := []struct {
+		name  string
+		stats repositories.ShortViewStats
+		want  repositories.ShortViewCounters
+	}{
+		{
+			name:  "한 번 시청",
+			stats: repositories.ShortViewStats{Views: 1, DwellMs: 5000, Completions: 1},
+			want:  repositories.ShortViewCounters{Views: 1, DwellMs: 5000, Completions: 1},
+		},
+		{
+			name:  "반복 이벤트는 시청/완주/스킵 1회로",
+			stats: repositories.ShortViewStats{Views: 100, DwellMs: 100 * 1000, Completions: 40, Skips: 60},
+			want:  repositories.ShortViewCounters{Views: 1, DwellMs: 100 * 1000, Completions: 1, Skips: 1},
+		},
+		{
+			name:  "시청 시간은 최대 10분",
+			stats: repositories.ShortViewStats{Views: 100, DwellMs: 100 * shortViewMaxDwellMs},
+			want:  repositories.ShortViewCounters{Views: 1, DwellMs: shortViewMaxDwellMs},
+		},
+		{
+			name:  "노출만 되고 바로 넘김",
+			stats: repositories.ShortViewStats{Views: 1, Skips: 1},
+			want:  repositories.ShortViewCounters{Views: 1, Skips: 1},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := countedShortView(tt.stats); got != tt.want {
+				t.Errorf("countedShortView() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,408 @@
+package services
+
+import (
+	"fmt"
+	"log"
+	"newsclip/backend/config"
+	"newsclip/backend/internal/app/models"
+	"newsclip/backend/internal/app/repositories"
+	"sort"
+	"strings"
+	"sync/atomic"
+	"time"
+)
+
+// 급상승 이슈 설정값
+const (
+	maxTrendTopics          = 20  // 1회 감지 시 저장할 최대 이슈 수
+	maxTrendRelatedTags     = 5   // 이슈당 함께 보여줄 연관 태그 수
+	trendClusterOverlap     = 0.5 // 기사의 절반 이상이 겹치면 같은 이슈로 묶음
+	trendArticleCandidates  = 10  // 대표 기사 선택 시 살펴볼 기사 수 (뮤트 제외 후 선택)
+	trendRepresentativeSize = 3   // 이슈당 대표 기사 수
+	trendHistoryDays        = 7   // 감지 결과 보관 기간
+	trendNotifyCooldown     = 24 * time.Hour
+	trendDefaultSize        = 10
+	trendMaxSize            = maxTrendTopics
+)
+
+// === 급상승 이슈 감지 정책 ===
+// 최근 구간(RecentHours)에 수집된 기사의 태그별 기사 수를 기준 구간(BaselineHours)의 평균과 비교
+type TrendDetectionPolicy struct {
+	RecentHours         int     `json:"recentHours"`         // 최근 구간 (TREND_RECENT_HOURS)
+	BaselineHours       int     `json:"baselineHours"`       // 기준 구간, 최근 구간 직전 (TREND_BASELINE_HOURS)
+	MinArticles         int     `json:"minArticles"`         // 이슈로 보기 위한 최소 기사 수 (TREND_MIN_ARTICLES)
+	MinBurst            float64 `json:"minBurst"`            // 이슈로 보기 위한 최소 증가 배율 (TREND_MIN_BURST)
+	BreakingMinArticles int     `json:"breakingMinArticles"` // 속보 판단 최소 기사 수 (TREND_BREAKING_MIN_ARTICLES)
+	BreakingMinSources  int     `json:"breakingMinSources"`  // 속보 판단 최소 언론사 수 (TREND_BREAKING_MIN_SOURCES)
+	BreakingMinBurst    float64 `json:"breakingMinBurst"`    // 속보 판단 최소 증가 배율 (TREND_BREAKING_MIN_BURST)
+	NotifyBreaking      bool    `json:"notifyBreaking"`      // 속보를 키워드 알림 사용자에게 알림 (TREND_NOTIFY_BREAKING=true)
+}
+
+// 환경 변수에서 감지 정책 로드
+func LoadTrendDetectionPolicy() TrendDetectionPolicy {
+	policy := TrendDetectionPolicy{
+		RecentHours:         int(config.GetEnvFloat("TREND_RECENT_HOURS", 6)),
+		BaselineHours:       int(config.GetEnvFloat("TREND_BASELINE_HOURS", 48)),
+		MinArticles:         int(config.GetEnvFloat("TREND_MIN_ARTICLES", 3)),
+		MinBurst:            config.GetEnvFloat("TREND_MIN_BURST", 2.0),
+		BreakingMinArticles: int(config.GetEnvFloat("TREND_BREAKING_MIN_ARTICLES", 5)),
+		BreakingMinSources:  int(config.GetEnvFloat("TREND_BREAKING_MIN_SOURCES", 3)),
+		BreakingMinBurst:    config.GetEnvFloat("TREND_BREAKING_MIN_BURST", 4.0),
+		NotifyBreaking:      config.GetEnv("TREND_NOTIFY_BREAKING") == "true",
+	}
+	if policy.RecentHours < 1 {
+		policy.RecentHours = 6
+	}
+	if policy.BaselineHours < 1 {
+		policy.BaselineHours = 48
+	}
+	if policy.MinArticles < 1 {
+		policy.MinArticles = 3
+	}
+	return policy
+}
+
+// 동시에 하나의 감지 작업만 실행
+var trendDetectionInProgress atomic.Bool
+
+// === 급상승 이슈 감지 (주기 작업) ===
+func DetectTrends() {
+	if !trendDetectionInProgress.CompareAndSwap(false, true) {
+		return
+	}
+	defer trendDetectionInProgress.Store(false)
+
+	policy := LoadTrendDetectionPolicy()
+	now := time.Now()
+
+	topics, err := detectTrendTopics(policy, now)
+	if err != nil {
+		log.Printf("🔥 [Trends] Detection failed: %v", err)
+		return
+	}
+	if err := repositories.CreateTrendTopics(now, topics); err != nil {
+		log.Printf("🔥 [Trends] Failed to save topics: %v", err)
+		return
+	}
+
+	breaking := 0
+	for i := range topics {
+		if topics[i].IsBreaking {
+			breaking++
+			if policy.NotifyBreaking {
+				notifyBreakingTopic(&topics[i], now)
+			}
+		}
+	}
+	log.Printf("📈 [Trends] Detected %d topics (%d breaking)", len(topics), breaking)
+
+	if _, err := repositories.DeleteTrendTopicsBefore(now.AddDate(0, 0, -trendHistoryDays)); err != nil {
+		log.Printf("⚠️ [Trends] Failed to purge old topics: %v", err)
+	}
+}
+
+// (내부 함수) 태그별 기사 수 급증을 찾아 이슈 단위로 묶음
+func detectTrendTopics(policy TrendDetectionPolicy, now time.Time) ([]models.TrendTopic, error) {
+	recentFrom := now.Add(-time.Duration(policy.RecentHours) * time.Hour)
+	baselineFrom := recentFrom.Add(-time.Duration(policy.BaselineHours) * time.Hour)
+
+	// 1. 최근/기준 구간 태그별 기사 수
+	recent, err := repositories.CountTagArticles(recentFrom, now)
+	if err != nil {
+		return nil, err
+	}
+	baselineRows, err := repositories.CountTagArticles(baselineFrom, recentFrom)
+	if err != nil {
+		return nil, err
+	}
+	baseline := make(map[string]int, len(baselineRows))
+	for _, row := range baselineRows {
+		baseline[row.Tag] = row.ArticleCount
+	}
+
+	// 2. 급상승 후보 (기준 구간을 최근 구간 길이로 환산한 기대값 대비 증가 배율)
+	type candidate struct {
+		tag   repositories.TagArticleCount
+		burst float64
+	}
+	var candidates []candidate
+	scale := float64(policy.RecentHours) / float64(policy.BaselineHours)
+	for _, row := range recent {
+		if row.ArticleCount < policy.MinArticles {
+			continue
+		}
+		expected := float64(baseline[row.Tag]) * scale
+		burst := (float64(row.ArticleCount) + 1) / (expected + 1)
+		if burst < policy.MinBurst {
+			continue
+		}
+		candidates = append(candidates, candidate{row, burst})
+	}
+	if len(candidates) == 0 {
+		return []models.TrendTopic{}, nil
+	}
+	sort.Slice(candidates, func(i, j int) bool {
+		if candidates[i].burst != candidates[j].burst {
+			return candidates[i].burst > candidates[j].burst
+		}
+		if candidates[i].tag.ArticleCount != candidates[j].tag.ArticleCount {
+			return candidates[i].tag.ArticleCount > candidates[j].tag.ArticleCount
+		}
+		return candidates[i].tag.Tag < candidates[j].tag.Tag
+	})
+
+	// 3. 후보 태그별 기사 목록 (최신 발행순)
+	tags := make([]string, len(candidates))
+	for i, c := range candidates {
+		tags[i] = c.tag.Tag
+	}
+	rows, err := repositories.FindTagNews(tags, recentFrom)
+	if err != nil {
+		return nil, err
+	}
+	tagNews := make(map[string][]uint)
+	newsRank := make(map[uint]int)
+	newsSource := make(map[uint]string)
+	for _, row := range rows {
+		tagNews[row.Tag] = append(tagNews[row.Tag], row.NewsID)
+		if _, ok := newsRank[row.NewsID]; !ok {
+			newsRank[row.NewsID] = len(newsRank)
+			newsSource[row.NewsID] = row.Source
+		}
+	}
+
+	// 4. 기사가 많이 겹치는 태그는 하나의 이슈로 묶음 (배율이 가장 높은 태그가 대표)
+	type cluster struct {
+		topic *models.TrendTopic
+		news  map[uint]bool
+	}
+	var clusters []*cluster
+	for _, c := range candidates {
+		ids := tagNews[c.tag.Tag]
+		if len(ids) == 0 {
+			continue
+		}
+
+		var merged *cluster
+		for _, cl := range clusters {
+			overlap := 0
+			for _, id := range ids {
+				if cl.news[id] {
+					overlap++
+				}
+			}
+			if float64(overlap)/float64(len(ids)) >= trendClusterOverlap {
+				merged = cl
+				break
+			}
+		}
+
+		if merged != nil {
+			if len(merged.topic.RelatedTags) < maxTrendRelatedTags {
+				merged.topic.RelatedTags = append(merged.topic.RelatedTags, c.tag.Tag)
+			}
+			for _, id := range ids {
+				merged.news[id] = true
+			}
+			continue
+		}
+		if len(clusters) >= maxTrendTopics {
+			continue
+		}
+
+		cl := &cluster{
+			topic: &models.TrendTopic{
+				DetectedAt:    now,
+				Topic:         c.tag.Tag,
+				TopicType:     c.tag.TagType,
+				RelatedTags:   []string{},
+				BaselineCount: baseline[c.tag.Tag],
+				BurstScore:    float64(int(c.burst*100)) / 100,
+			},
+			news: make(map[uint]bool, len(ids)),
+		}
+		for _, id := range ids {
+			cl.news[id] = true
+		}
+		clusters = append(clusters, cl)
+	}
+
+	// 5. 이슈별 기사 수/언론사 수 + 속보 판단
+	topics := make([]models.TrendTopic, 0, len(clusters))
+	for _, cl := range clusters {
+		topic := cl.topic
+
+		ids := make([]uint, 0, len(cl.news))
+		sources := make(map[string]bool)
+		for id := range cl.news {
+			ids = append(ids, id)
+			sources[newsSource[id]] = true
+		}
+		sort.Slice(ids, func(i, j int) bool { return newsRank[ids[i]] < newsRank[ids[j]] })
+
+		topic.NewsIDs = ids
+		topic.ArticleCount = len(ids)
+		topic.SourceCount = len(sources)
+		topic.IsBreaking = topic.ArticleCount >= policy.BreakingMinArticles &&
+			topic.SourceCount >= policy.BreakingMinSources &&
+			topic.BurstScore >= policy.BreakingMinBurst
+		topics = append(topics, *topic)
+	}
+	return topics, nil
+}
+
+// (내부 함수) 속보 이슈를 관련 키워드 알림 사용자에게 알림 (같은 이슈는 하루 1번)
+func notifyBreakingTopic(topic *models.TrendTopic, now time.Time) {
+	if len(topic.NewsIDs) == 0 {
+		return
+	}
+	notified, err := repositories.HasNotifiedTrendTopic(topic.Topic, now.Add(-trendNotifyCooldown))
+	if err != nil || notified {
+		return
+	}
+
+	keywords := []string{strings.ToLower(topic.Topic)}
+	for _, tag := range topic.RelatedTags {
+		keywords = append(keywords, strings.ToLower(tag))
+	}
+	userIDs, err := repositories.FindUserIDsByAlertKeywords(keywords)
+	if err != nil {
+		log.Printf("🔥 [Trends] Failed to load alert keyword users for %s: %v", topic.Topic, err)
+		return
+	}
+
+	// 대표 기사 제목을 본문으로 사용
+	body := ""
+	if news, err := repositories.FindNewsByID(topic.NewsIDs[0]); err == nil {
+		body = news.Title
+	}
+
+	notifications := make([]models.Notification, len(userIDs))
+	for i, userID := range userIDs {
+		notifications[i] = models.Notification{
+			UserID:   userID,
+			Title:    fmt.Sprintf("[속보] %s", topic.Topic),
+			Body:     body,
+			Deeplink: fmt.Sprintf("/news/%d", topic.NewsIDs[0]),
+		}
+	}
+	if err := repositories.CreateNotifications(notifications); err != nil {
+		log.Printf("🔥 [Trends] Failed to create notifications for %s: %v", topic.Topic, err)
+		return
+	}
+	if err := repositories.MarkTrendTopicNotified(topic.ID, now); err != nil {
+		log.Printf("⚠️ [Trends] Failed to mark %s as notified: %v", topic.Topic, err)
+	}
+	log.Printf("🔔 [Trends] Breaking topic %s notified to %d users", topic.Topic, len(userIDs))
+}
+
+// === 급상승 이슈 DTO ===
+type TrendTopicDTO struct {
+	Topic        string                   `json:"topic"`
+	TopicType    string                   `json:"topicType"`
+	RelatedTags  []string                 `json:"relatedTags"`
+	ArticleCount int                      `json:"articleCount"`
+	SourceCount  int                      `json:"sourceCount"`
+	BurstScore   float64                  `json:"burstScore"`
+	IsBreaking   bool                     `json:"isBreaking"`
+	Articles     []RecommendedNewsItemDTO `json:"articles"` // 대표 기사 (가능하면 서로 다른 언론사)
+}
+
+type TrendListDTO struct {
+	DetectedAt *time.Time      `json:"detectedAt"` // 감지 시각 (최근 감지 결과가 없으면 null)
+	Topics     []TrendTopicDTO `json:"topics"`
+}
+
+// === 급상승 이슈 목록 조회 서비스 ===
+// 최근 구간 2배 이내에 감지된 결과만 보여줌 (감지 작업이 멈춘 경우 오래된 이슈 노출 방지)
+func GetTrends(size int, userID uint) (*TrendListDTO, error) {
+	if size <= 0 {
+		size = trendDefaultSize
+	}
+	if size > trendMaxSize {
+		size = trendMaxSize
+	}
+
+	policy := LoadTrendDetectionPolicy()
+	response := &TrendListDTO{Topics: []TrendTopicDTO{}}
+
+	// 1. 최근 감지 결과
+	topics, err := repositories.FindLatestTrendTopics(size)
+	if err != nil {
+		return nil, err
+	}
+	if len(topics) == 0 || time.Since(topics[0].DetectedAt) > 2*time.Duration(policy.RecentHours)*time.Hour {
+		return response, nil
+	}
+	response.DetectedAt = &topics[0].DetectedAt
+
+	// 2. 대표 기사 후보 한 번에 조회 (뮤트 반영)
+	var ids []uint
+	for _, t := range topics {
+		ids = append(ids, firstIDs(t.NewsIDs, trendArticleCandidates)...)
+	}
+	newsList, err := repositories.FindNewsByIDsForUser(ids, userID)
+	if err != nil {
+		return nil, err
+	}
+	newsByID := make(map[uint]models.News, len(newsList))
+	for _, n := range newsList {
+		newsByID[n.ID] = n
+	}
+
+	// 3. 이슈별 DTO (보이는 기사가 없는 이슈는 제외)
+	for _, t := range topics {
+		articles := pickRepresentativeNews(firstIDs(t.NewsIDs, trendArticleCandidates), newsByID)
+		if len(articles) == 0 {
+			continue
+		}
+		relatedTags := t.RelatedTags
+		if relatedTags == nil {
+			relatedTags = []string{}
+		}
+		response.Topics = append(response.Topics, TrendTopicDTO{
+			Topic:        t.Topic,
+			TopicType:    t.TopicType,
+			RelatedTags:  relatedTags,
+			ArticleCount: t.ArticleCount,
+			SourceCount:  t.SourceCount,
+			BurstScore:   t.BurstScore,
+			IsBreaking:   t.IsBreaking,
+			Articles:     toRecommendedNewsItems(articles),
+		})
+	}
+	return response, nil
+}
+
+// (헬퍼 함수) 대표 기사 선택 (최신순, 서로 다른 언론사 우선)
+func pickRepresentativeNews(ids []uint, newsByID map[uint]models.News) []models.News {
+	var picked, rest []models.News
+	sources := make(map[string]bool)
+	for _, id := range ids {
+		n, ok := newsByID[id]
+		if !ok {
+			continue
+		}
+		if !sources[n.Source] && len(picked) < trendRepresentativeSize {
+			sources[n.Source] = true
+			picked = append(picked, n)
+		} else {
+			rest = append(rest, n)
+		}
+	}
+	for _, n := range rest {
+		if len(picked) >= trendRepresentativeSize {
+			break
+		}
+		picked = append(picked, n)
+	}
+	return picked
+}
+
+// (헬퍼 함수) 앞에서부터 n개
+func firstIDs(ids []uint, n int) []uint {
+	if len(ids) > n {
+		return ids[:n]
+	}
+	return ids
+}
@@ -0,0 +1,114 @@
+package services
+
+import (
+	"encoding/json"
+	"fmt"
+	"newsclip/backend/internal/app/models"
+	"newsclip/backend/internal/app/repositories"
+	"newsclip/backend/pkg/redis"
+	"time"
+)
+
+// 트렌딩 설정값
+const (
+	trendingWindowHours = 72              // 최근 72시간 이내 발행 기사만
+	trendingDefaultSize = 10              // 기본 개수
+	trendingMaxSize     = 50              // 최대 개수
+	trendingCacheTTL    = 5 * time.Minute // 비로그인 요청 캐시 유지 시간
+)
+
+// 트렌딩 점수 가중치 (조회 < 좋아요 < 댓글 < 북마크 순으로 참여 강도가 큼)
+var trendingWeights = repositories.TrendingWeights{
+	View:     1.0,
+	Like:     3.0,
+	Comment:  4.0,
+	Bookmark: 5.0,
+	Gravity:  1.5,
+}
+
+// === 트렌딩 뉴스 응답 DTO ===
+type TrendingNewsResponseDTO struct {
+	News []RecommendedNewsItemDTO `json:"news"`
+}
+
+// 비로그인 트렌딩 캐시 키
+func trendingCacheKey(category string, size int) string {
+	return fmt.Sprintf("news_trending:%s:%d", category, size)
+}
+
+// === 트렌딩 뉴스 조회 서비스 ===
+// 비로그인 요청은 결과(ID 목록)를 잠시 캐시, 로그인 사용자는 뮤트 설정을 반영해 매번 계산
+func GetTrendingNews(category string, size int, userID uint) (*TrendingNewsResponseDTO, error) {
+	if size <= 0 {
+		size = trendingDefaultSize
+	}
+	if size > trendingMaxSize {
+		size = trendingMaxSize
+	}
+
+	newsList, err := findTrendingNews(category, size, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &TrendingNewsResponseDTO{
+		News: toRecommendedNewsItems(newsList),
+	}, nil
+}
+
+// (헬퍼 함수) 트렌딩 뉴스 조회 (비로그인은 캐시 사용)
+func findTrendingNews(category string, size int, userID uint) ([]models.News, error) {
+	since := time.Now().Add(-trendingWindowHours * time.Hour)
+
+	if userID != 0 {
+		return repositories.FindTrendingNews(category, since, trendingWeights, userID, size)
+	}
+
+	// 1. 캐시 확인
+	key := trendingCacheKey(category, size)
+	if raw, err := redis.GetData(key); err == nil {
+		var ids []uint
+		if json.Unmarshal([]byte(raw), &ids) == nil {
+			if newsList, err := findNewsInOrder(ids); err == nil {
+				return newsList, nil
+			}
+		}
+	}
+
+	// 2. 계산 후 캐시 저장 (실패해도 응답에는 영향 없음)
+	newsList, err := repositories.FindTrendingNews(category, since, trendingWeights, 0, size)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]uint, len(newsList))
+	for i, n := range newsList {
+		ids[i] = n.ID
+	}
+	if data, err := json.Marshal(ids); err == nil {
+		_ = redis.SetData(key, string(data), trendingCacheTTL)
+	}
+
+	return newsList, nil
+}
+
+// (헬퍼 함수) ID 순서대로 뉴스 조회 (그 사이 삭제된 기사는 건너뜀)
+func findNewsInOrder(ids []uint) ([]models.News, error) {
+	newsList, err := repositories.FindNewsByIDs(ids)
+	if err != nil {
+		return nil, err
+	}
+
+	newsMap := make(map[uint]models.News, len(newsList))
+	for _, n := range newsList {
+		newsMap[n.ID] = n
+	}
+
+	ordered := make([]models.News, 0, len(ids))
+	for _, id := range ids {
+		if n, ok := newsMap[id]; ok {
+			ordered = append(ordered, n)
+		}
+	}
+	return ordered, nil
+}
@@ -0,0 +1,141 @@
+package extractor
+
+import (
+	"encoding/json"
+	"net/url"
+	"os"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+// 사이트 규칙으로 뽑은 본문이 이보다 짧으면 범용 추출기로 다시 시도 (글자 수)
+const minRuleTextRunes = 100
+
+// 어떤 추출기를 쓰든 본문에서 항상 제거하는 요소
+var commonRemoveSelectors = []string{
+	"script", "style", "noscript", "iframe", "img", "figure", "figcaption",
+	".end_photo_org", ".img_desc",
+}
+
+// === 사이트별 본문 추출 규칙 ===
+type Rule struct {
+	Name      string   `json:"name"`
+	Hosts     []string `json:"hosts"`     // 적용할 도메인 (서브도메인 포함, 예: "naver.com")
+	Selectors []string `json:"selectors"` // 본문 영역 CSS 셀렉터 (앞에서부터 시도)
+	Remove    []string `json:"remove"`    // 본문 안에서 추가로 제거할 요소
+}
+
+// 설정 파일이 없을 때 사용하는 기본 규칙 (네이버 뉴스)
+var DefaultRules = []Rule{
+	{
+		Name:      "naver",
+		Hosts:     []string{"n.news.naver.com", "news.naver.com"},
+		Selectors: []string{"#dic_area", "#newsct_article"},
+	},
+}
+
+// === 추출 결과 ===
+type Result struct {
+	Text      string // 공백 정리된 본문
+	Extractor string // 사용한 추출기 이름 (규칙 이름 또는 "readability")
+}
+
+// === 호스트 → 규칙 레지스트리 ===
+type Registry struct {
+	rules  []Rule
+	byHost map[string]int // 호스트 → rules 인덱스
+}
+
+// 새 레지스트리 생성
+func NewRegistry(rules []Rule) *Registry {
+	r := &Registry{byHost: make(map[string]int)}
+	for _, rule := range rules {
+		r.Register(rule)
+	}
+	return r
+}
+
+// JSON 설정 파일([]Rule)에서 레지스트리 생성
+func LoadRegistry(path string) (*Registry, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var rules []Rule
+	if err := json.Unmarshal(data, &rules); err != nil {
+		return nil, err
+	}
+	return NewRegistry(rules), nil
+}
+
+// 규칙 등록 (같은 호스트는 나중에 등록한 규칙이 우선)
+func (r *Registry) Register(rule Rule) {
+	r.rules = append(r.rules, rule)
+	for _, host := range rule.Hosts {
+		r.byHost[normalizeHost(host)] = len(r.rules) - 1
+	}
+}
+
+// 등록된 규칙 수
+func (r *Registry) Len() int {
+	return len(r.rules)
+}
+
+// 호스트에 맞는 규칙 찾기 (정확히 일치하지 않으면 상위 도메인으로 올라가며 검색)
+func (r *Registry) Match(host string) (Rule, bool) {
+	host = normalizeHost(host)
+	for host != "" {
+		if idx, ok := r.byHost[host]; ok {
+			return r.rules[idx], true
+		}
+		dot := strings.IndexByte(host, '.')
+		if dot < 0 {
+			break
+		}
+		host = host[dot+1:]
+	}
+	return Rule{}, false
+}
+
+// === 본문 추출 ===
+// 1. 호스트에 맞는 사이트 규칙이 있으면 셀렉터 순서대로 시도
+// 2. 규칙이 없거나 결과가 너무 짧으면 텍스트 밀도 기반 범용 추출기 사용
+func (r *Registry) Extract(pageURL string, doc *goquery.Document) Result {
+	doc.Find(strings.Join(commonRemoveSelectors, ", ")).Remove()
+
+	if u, err := url.Parse(pageURL); err == nil {
+		if rule, ok := r.Match(u.Hostname()); ok {
+			for _, selector := range rule.Selectors {
+				selection := doc.Find(selector).First()
+				if selection.Length() == 0 {
+					continue
+				}
+				if len(rule.Remove) > 0 {
+					selection.Find(strings.Join(rule.Remove, ", ")).Remove()
+				}
+				if text := selectionText(selection); utf8.RuneCountInString(text) >= minRuleTextRunes {
+					return Result{Text: text, Extractor: rule.Name}
+				}
+			}
+		}
+	}
+
+	return Result{Text: extractReadable(doc), Extractor: "readability"}
+}
+
+// (헬퍼 함수) 호스트 정규화 (소문자, www. 제거)
+func normalizeHost(host string) string {
+	host = strings.ToLower(strings.TrimSpace(host))
+	return strings.TrimPrefix(host, "www.")
+}
+
+// (헬퍼 함수) 선택 영역의 텍스트를 공백 정리하여 반환
+// <br>/문단 경계에 공백을 넣지 않으면 "안녕하세요<br>반갑습니다"가 붙어버림
+func selectionText(selection *goquery.Selection) string {
+	selection.Find("br").ReplaceWithHtml(" ")
+	selection.Find("p, div, li, h1, h2, h3, h4, tr").AppendHtml(" ")
+	return strings.Join(strings.Fields(selection.Text()), " ")
+}
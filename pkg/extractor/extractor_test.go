@@ -0,0 +1,129 @@
+package extractor
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+// 사이트 규칙 최소 길이(minRuleTextRunes)를 넘는 문단
+var longParagraph = strings.Repeat("정부는 반도체 산업 지원을 위해 세제 혜택을 확대한다고 밝혔다. ", 5)
+
+func parseHTML(t *testing.T, body string) *goquery.Document {
+	t.Helper()
+	doc, err := goquery.NewDocumentFromReader(strings.NewReader(body))
+	if err != nil {
+		t.Fatalf("parse html: %v", err)
+	}
+	return doc
+}
+
+func TestRegistryMatch(t *testing.T) {
+	registry := NewRegistry([]Rule{
+		{Name: "naver", Hosts: []string{"n.news.naver.com", "news.naver.com"}},
+		{Name: "yna", Hosts: []string{"www.yna.co.kr"}},
+		{Name: "yna-override", Hosts: []string{"yna.co.kr"}},
+	})
+
+	tests := []struct {
+		host     string
+		wantName string
+		wantOK   bool
+	}{
+		{"n.news.naver.com", "naver", true},
+		{"N.News.Naver.com", "naver", true},
+		{"sports.news.naver.com", "naver", true}, // 상위 도메인으로 올라가며 검색
+		{"naver.com", "", false},
+		{"yna.co.kr", "yna-override", true}, // www. 제거 후 나중 규칙 우선
+		{"www.yna.co.kr", "yna-override", true},
+		{"m.yna.co.kr", "yna-override", true},
+		{"example.com", "", false},
+		{"", "", false},
+	}
+	for _, tt := range tests {
+		rule, ok := registry.Match(tt.host)
+		if ok != tt.wantOK || rule.Name != tt.wantName {
+			t.Errorf("Match(%q) = (%q, %v), want (%q, %v)", tt.host, rule.Name, ok, tt.wantName, tt.wantOK)
+		}
+	}
+	if registry.Len() != 3 {
+		t.Errorf("Len() = %d, want 3", registry.Len())
+	}
+}
+
+func TestExtract(t *testing.T) {
+	registry := NewRegistry(DefaultRules)
+
+	tests := []struct {
+		name          string
+		url           string
+		html          string
+		wantExtractor string
+		wantContains  []string
+		wantMissing   []string
+	}{
+		{
+			name: "사이트 규칙 셀렉터",
+			url:  "https://n.news.naver.com/article/001/0000001",
+			html: `<html><body><div class="menu">메뉴</div><article id="dic_area">` + longParagraph +
+				`<script>alert(1)</script><span class="end_photo_org"><img src="a.jpg">사진 설명</span></article></body></html>`,
+			wantExtractor: "naver",
+			wantContains:  []string{"세제 혜택을 확대한다고"},
+			wantMissing:   []string{"메뉴", "alert", "사진 설명"},
+		},
+		{
+			name:          "두 번째 셀렉터로 폴백",
+			url:           "https://n.news.naver.com/article/001/0000002",
+			html:          `<html><body><div id="newsct_article">` + longParagraph + `</div></body></html>`,
+			wantExtractor: "naver",
+			wantContains:  []string{"반도체 산업"},
+		},
+		{
+			name:          "규칙 결과가 너무 짧으면 범용 추출기",
+			url:           "https://n.news.naver.com/article/001/0000003",
+			html:          `<html><body><div id="dic_area">짧음</div><div class="article_body"><p>` + longParagraph + `</p></div></body></html>`,
+			wantExtractor: "readability",
+			wantContains:  []string{"반도체 산업"},
+		},
+		{
+			name: "규칙 없는 사이트는 텍스트 밀도로 본문 선택",
+			url:  "https://www.example.com/news/1",
+			html: `<html><body>
+				<nav><p>홈 정치 경제 사회 국제 문화 스포츠 연예 전체 메뉴 보기</p></nav>
+				<div class="article-body"><p>` + longParagraph + `</p><p>` + longParagraph + `</p></div>
+				<div class="related"><p><a href="/1">관련 기사 제목이 길게 이어지는 링크 목록입니다 첫번째</a></p></div>
+				<footer><p>Copyright 무단 전재 및 재배포 금지 모든 권리 보유 회사 주소</p></footer>
+			</body></html>`,
+			wantExtractor: "readability",
+			wantContains:  []string{"세제 혜택을 확대한다고"},
+			wantMissing:   []string{"전체 메뉴", "관련 기사", "Copyright"},
+		},
+		{
+			name:          "br 로 나뉜 문장은 공백으로 구분",
+			url:           "https://www.example.com/news/2",
+			html:          `<html><body><div class="content">첫 번째 문장이 이어지는 본문 내용입니다.<br>두 번째 문장이 이어지는 본문 내용입니다.</div></body></html>`,
+			wantExtractor: "readability",
+			wantContains:  []string{"내용입니다. 두 번째"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := registry.Extract(tt.url, parseHTML(t, tt.html))
+			if result.Extractor != tt.wantExtractor {
+				t.Errorf("Extractor = %q, want %q", result.Extractor, tt.wantExtractor)
+			}
+			for _, s := range tt.wantContains {
+				if !strings.Contains(result.Text, s) {
+					t.Errorf("Text missing %q: %q", s, result.Text)
+				}
+			}
+			for _, s := range tt.wantMissing {
+				if strings.Contains(result.Text, s) {
+					t.Errorf("Text contains %q: %q", s, result.Text)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,140 @@
+package extractor
+
+import (
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/PuerkitoBio/goquery"
+	"golang.org/x/net/html"
+)
+
+// 문단으로 인정하는 최소 글자 수 (메뉴/버튼 텍스트 제외)
+const minParagraphRunes = 25
+
+// 본문 후보가 아닌 영역 (범용 추출 전에 제거)
+var boilerplateSelectors = []string{
+	"nav", "header", "footer", "aside", "form", "button", "select", "textarea",
+}
+
+// class/id 에 따른 후보 가산/감산 패턴
+var (
+	positiveHint = regexp.MustCompile(`(?i)article|body|content|entry|main|news|post|text|story|view`)
+	negativeHint = regexp.MustCompile(`(?i)comment|footer|sidebar|side|related|banner|share|sns|copyright|menu|nav|reply|recommend|popular|rank|promo|\bad\b|ads`)
+)
+
+// === 텍스트 밀도 기반 범용 본문 추출 (readability 방식) ===
+// 1. 문단(p, 텍스트가 직접 들어있는 div)마다 길이/쉼표 수로 점수를 매겨 부모(100%)와 조부모(50%)에 누적
+// 2. class/id 힌트와 링크 밀도로 후보 점수 보정
+// 3. 최고 점수 후보의 텍스트 반환 (후보가 없으면 article → body 순으로 폴백)
+func extractReadable(doc *goquery.Document) string {
+	doc.Find(strings.Join(boilerplateSelectors, ", ")).Remove()
+
+	scores := make(map[*html.Node]float64)
+	selections := make(map[*html.Node]*goquery.Selection)
+
+	addScore := func(s *goquery.Selection, score float64) {
+		if s.Length() == 0 {
+			return
+		}
+		node := s.Get(0)
+		if _, ok := scores[node]; !ok {
+			scores[node] = classWeight(s)
+			selections[node] = s
+		}
+		scores[node] += score
+	}
+
+	doc.Find("p, div").Each(func(i int, s *goquery.Selection) {
+		var text string
+		if goquery.NodeName(s) == "div" {
+			text = ownText(s)
+		} else {
+			text = strings.TrimSpace(s.Text())
+		}
+
+		length := utf8.RuneCountInString(text)
+		if length < minParagraphRunes {
+			return
+		}
+
+		score := 1.0 + float64(strings.Count(text, ",")+strings.Count(text, "."))
+		score += minFloat(float64(length)/100.0, 3.0)
+
+		if goquery.NodeName(s) == "div" {
+			// 텍스트를 직접 담은 div는 그 자체가 본문 컨테이너인 경우가 많음 (국내 언론사 다수)
+			addScore(s, score)
+		}
+		addScore(s.Parent(), score)
+		addScore(s.Parent().Parent(), score/2)
+	})
+
+	var best *goquery.Selection
+	bestScore := 0.0
+	for node, score := range scores {
+		s := selections[node]
+		score *= 1 - linkDensity(s)
+		if score > bestScore {
+			best, bestScore = s, score
+		}
+	}
+
+	if best == nil {
+		best = doc.Find("article").First()
+	}
+	if best.Length() == 0 {
+		best = doc.Find("body")
+	}
+	return selectionText(best)
+}
+
+// (헬퍼 함수) class/id 힌트 점수
+func classWeight(s *goquery.Selection) float64 {
+	weight := 0.0
+	for _, attr := range []string{"class", "id"} {
+		value, ok := s.Attr(attr)
+		if !ok || value == "" {
+			continue
+		}
+		if negativeHint.MatchString(value) {
+			weight -= 25
+		}
+		if positiveHint.MatchString(value) {
+			weight += 25
+		}
+	}
+	return weight
+}
+
+// (헬퍼 함수) 링크 텍스트 비율 (0~1, 높을수록 목록/메뉴에 가까움)
+func linkDensity(s *goquery.Selection) float64 {
+	total := utf8.RuneCountInString(strings.TrimSpace(s.Text()))
+	if total == 0 {
+		return 0
+	}
+	linkText := 0
+	s.Find("a").Each(func(i int, a *goquery.Selection) {
+		linkText += utf8.RuneCountInString(strings.TrimSpace(a.Text()))
+	})
+	return float64(linkText) / float64(total)
+}
+
+// (헬퍼 함수) 자식 요소를 제외한, 노드가 직접 가진 텍스트
+func ownText(s *goquery.Selection) string {
+	var sb strings.Builder
+	for c := s.Get(0).FirstChild; c != nil; c = c.NextSibling {
+		if c.Type == html.TextNode {
+			sb.WriteString(c.Data)
+		} else if c.Type == html.ElementNode && c.Data == "br" {
+			sb.WriteString(" ")
+		}
+	}
+	return strings.TrimSpace(sb.String())
+}
+
+func minFloat(a, b float64) float64 {
+	if a < b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,364 @@
+package fetcher
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand"
+	"net/http"
+	"net/url"
+	"strconv"
+	"sync"
+	"time"
+
+	"golang.org/x/net/html/charset"
+)
+
+var (
+	ErrDisallowedByRobots = errors.New("robots.txt 에 의해 수집이 금지된 URL")
+	ErrBodyTooLarge       = errors.New("응답 본문이 최대 크기를 초과")
+)
+
+// 2xx/304 가 아닌 응답 (재시도 후에도 실패한 경우)
+type StatusError struct {
+	URL        string
+	StatusCode int
+}
+
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("unexpected status %d for %s", e.StatusCode, e.URL)
+}
+
+// === 수집기 설정 ===
+type Options struct {
+	UserAgent          string
+	Timeout            time.Duration // 요청 1회 타임아웃
+	MaxBodyBytes       int64         // 응답 본문 최대 크기
+	PerHostConcurrency int           // 호스트별 동시 요청 수
+	PerHostInterval    time.Duration // 같은 호스트 요청 간 최소 간격 (robots.txt Crawl-delay 가 더 크면 그 값 사용)
+	MaxRetries         int           // 네트워크 오류/429/5xx 재시도 횟수
+	RetryBaseDelay     time.Duration // 재시도 대기 시간 (지수 증가)
+	RespectRobots      bool
+}
+
+// 기본 설정
+func DefaultOptions() Options {
+	return Options{
+		UserAgent:          "NewsclipBot/1.0 (+https://newsclip.app/bot)",
+		Timeout:            10 * time.Second,
+		MaxBodyBytes:       5 << 20, // 5MB
+		PerHostConcurrency: 2,
+		PerHostInterval:    500 * time.Millisecond,
+		MaxRetries:         2,
+		RetryBaseDelay:     500 * time.Millisecond,
+		RespectRobots:      true,
+	}
+}
+
+// === 조건부 요청 검증값 (ETag / Last-Modified) ===
+// 수집기는 본문을 보관하지 않으므로, 호출자가 이전 응답의 값을 저장해 두었다가 다시 넘겨줌
+type Validators struct {
+	ETag         string
+	LastModified string
+}
+
+// 검증값이 하나라도 있는지
+func (v Validators) IsZero() bool {
+	return v.ETag == "" && v.LastModified == ""
+}
+
+// === 수집 결과 ===
+type Response struct {
+	URL         *url.URL // 리다이렉트 이후 최종 URL
+	StatusCode  int
+	ContentType string
+	Body        []byte     // UTF-8 로 변환된 본문 (NotModified 이면 비어 있음)
+	Validators  Validators // 다음 조건부 요청에 쓸 검증값
+	NotModified bool       // 304 응답 (호출자가 저장해 둔 본문을 그대로 사용)
+}
+
+// === 공용 HTTP 수집기 ===
+// 호스트별 동시성/요청 간격 제한, robots.txt 준수, 재시도, 문자셋 변환, 조건부 요청을 처리
+type Fetcher struct {
+	opts   Options
+	client *http.Client
+
+	hostsMu sync.Mutex
+	hosts   map[string]*hostState
+}
+
+// 호스트별 제한 상태
+type hostState struct {
+	sem chan struct{}
+
+	mu   sync.Mutex
+	next time.Time // 다음 요청 가능 시각
+
+	robotsMu      sync.Mutex
+	robots        *robotsRules
+	robotsExpires time.Time
+}
+
+// 새 수집기 생성
+func New(opts Options) *Fetcher {
+	if opts.PerHostConcurrency < 1 {
+		opts.PerHostConcurrency = 1
+	}
+	return &Fetcher{
+		opts:   opts,
+		client: &http.Client{Timeout: opts.Timeout},
+		hosts:  make(map[string]*hostState),
+	}
+}
+
+// === URL 수집 ===
+func (f *Fetcher) Get(ctx context.Context, rawURL string) (*Response, error) {
+	return f.GetConditional(ctx, rawURL, Validators{})
+}
+
+// === 조건부 URL 수집 ===
+// 검증값이 있으면 If-None-Match / If-Modified-Since 를 보내고, 304 응답이면 NotModified 로 반환
+func (f *Fetcher) GetConditional(ctx context.Context, rawURL string, validators Validators) (*Response, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, err
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return nil, fmt.Errorf("unsupported scheme: %s", u.Scheme)
+	}
+
+	host := f.host(u.Host)
+
+	// 1. robots.txt 확인
+	crawlDelay := time.Duration(0)
+	if f.opts.RespectRobots {
+		rules := f.robotsFor(ctx, u, host)
+		if !rules.allowed(u.RequestURI()) {
+			return nil, ErrDisallowedByRobots
+		}
+		crawlDelay = rules.crawlDelay
+	}
+
+	// 2. 재시도 루프
+	var lastErr error
+	for attempt := 0; attempt <= f.opts.MaxRetries; attempt++ {
+		if attempt > 0 {
+			if err := sleepContext(ctx, f.backoff(attempt, lastErr)); err != nil {
+				return nil, err
+			}
+		}
+
+		resp, err := f.do(ctx, u, host, crawlDelay, validators)
+		if err == nil {
+			return resp, nil
+		}
+		lastErr = err
+		if ctx.Err() != nil || !isRetryable(err) {
+			break
+		}
+	}
+	return nil, lastErr
+}
+
+// (내부 함수) 요청 1회 수행
+func (f *Fetcher) do(ctx context.Context, u *url.URL, host *hostState, crawlDelay time.Duration, validators Validators) (*Response, error) {
+	key := u.String()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, key, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("User-Agent", f.opts.UserAgent)
+	req.Header.Set("Accept", "text/html,application/xhtml+xml;q=0.9,*/*;q=0.8")
+	req.Header.Set("Accept-Language", "ko-KR,ko;q=0.9,en;q=0.5")
+	if validators.ETag != "" {
+		req.Header.Set("If-None-Match", validators.ETag)
+	}
+	if validators.LastModified != "" {
+		req.Header.Set("If-Modified-Since", validators.LastModified)
+	}
+
+	// 호스트별 동시성/간격 제한
+	if err := host.acquire(ctx, maxDuration(f.opts.PerHostInterval, crawlDelay)); err != nil {
+		return nil, err
+	}
+	defer host.release()
+
+	res, err := f.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	// 304: 본문 없이 반환 (서버가 새 검증값을 주지 않으면 보낸 값 유지)
+	next := Validators{ETag: res.Header.Get("ETag"), LastModified: res.Header.Get("Last-Modified")}
+	if res.StatusCode == http.StatusNotModified && !validators.IsZero() {
+		if next.ETag == "" {
+			next.ETag = validators.ETag
+		}
+		if next.LastModified == "" {
+			next.LastModified = validators.LastModified
+		}
+		return &Response{URL: res.Request.URL, StatusCode: res.StatusCode, Validators: next, NotModified: true}, nil
+	}
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		return nil, &retryAfterError{
+			StatusError: &StatusError{URL: key, StatusCode: res.StatusCode},
+			retryAfter:  parseRetryAfter(res.Header.Get("Retry-After")),
+		}
+	}
+
+	// 최대 크기 제한
+	raw, err := io.ReadAll(io.LimitReader(res.Body, f.opts.MaxBodyBytes+1))
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(raw)) > f.opts.MaxBodyBytes {
+		return nil, ErrBodyTooLarge
+	}
+
+	// 문자셋 변환 (Content-Type 헤더 → <meta charset> → 내용 추정 순, EUC-KR/CP949 등)
+	contentType := res.Header.Get("Content-Type")
+	body, err := decodeBody(raw, contentType)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := Response{
+		URL:         res.Request.URL,
+		StatusCode:  res.StatusCode,
+		ContentType: contentType,
+		Body:        body,
+		Validators:  next,
+	}
+	return &resp, nil
+}
+
+// (헬퍼 함수) 본문을 UTF-8 로 변환
+func decodeBody(raw []byte, contentType string) ([]byte, error) {
+	enc, name, _ := charset.DetermineEncoding(raw, contentType)
+	if name == "utf-8" {
+		return raw, nil
+	}
+	decoded, err := io.ReadAll(enc.NewDecoder().Reader(bytes.NewReader(raw)))
+	if err != nil {
+		return nil, fmt.Errorf("decode %s: %w", name, err)
+	}
+	return decoded, nil
+}
+
+// (내부 함수) 호스트 상태 조회/생성
+func (f *Fetcher) host(name string) *hostState {
+	f.hostsMu.Lock()
+	defer f.hostsMu.Unlock()
+
+	h, ok := f.hosts[name]
+	if !ok {
+		h = &hostState{sem: make(chan struct{}, f.opts.PerHostConcurrency)}
+		f.hosts[name] = h
+	}
+	return h
+}
+
+// 호스트 요청 슬롯 획득 (동시성 제한 + 요청 간 최소 간격)
+func (h *hostState) acquire(ctx context.Context, interval time.Duration) error {
+	select {
+	case h.sem <- struct{}{}:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+
+	h.mu.Lock()
+	now := time.Now()
+	wait := h.next.Sub(now)
+	if wait < 0 {
+		wait = 0
+	}
+	h.next = now.Add(wait + interval)
+	h.mu.Unlock()
+
+	if err := sleepContext(ctx, wait); err != nil {
+		<-h.sem
+		return err
+	}
+	return nil
+}
+
+func (h *hostState) release() {
+	<-h.sem
+}
+
+// === 재시도 정책 ===
+
+// 상태 코드 오류 + Retry-After 헤더
+type retryAfterError struct {
+	*StatusError
+	retryAfter time.Duration
+}
+
+func (e *retryAfterError) Unwrap() error { return e.StatusError }
+
+// 재시도 대상: 네트워크 오류, 429, 5xx (robots 금지/크기 초과/4xx 는 재시도하지 않음)
+func isRetryable(err error) bool {
+	if errors.Is(err, ErrDisallowedByRobots) || errors.Is(err, ErrBodyTooLarge) {
+		return false
+	}
+	var statusErr *StatusError
+	if errors.As(err, &statusErr) {
+		return statusErr.StatusCode == http.StatusTooManyRequests || statusErr.StatusCode >= 500
+	}
+	return true
+}
+
+// 지수 백오프 + 지터 (Retry-After 가 있으면 최대 30초까지 우선)
+func (f *Fetcher) backoff(attempt int, lastErr error) time.Duration {
+	var rae *retryAfterError
+	if errors.As(lastErr, &rae) && rae.retryAfter > 0 {
+		return minDuration(rae.retryAfter, 30*time.Second)
+	}
+	delay := f.opts.RetryBaseDelay * time.Duration(1<<(attempt-1))
+	return delay + time.Duration(rand.Int63n(int64(delay)/2+1))
+}
+
+func parseRetryAfter(value string) time.Duration {
+	if value == "" {
+		return 0
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		return time.Duration(seconds) * time.Second
+	}
+	if t, err := http.ParseTime(value); err == nil {
+		return time.Until(t)
+	}
+	return 0
+}
+
+func sleepContext(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return nil
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func maxDuration(a, b time.Duration) time.Duration {
+	if a > b {
+		return a
+	}
+	return b
+}
+
+func minDuration(a, b time.Duration) time.Duration {
+	if a < b {
+		return a
+	}
+	return b
+}
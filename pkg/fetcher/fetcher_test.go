@@ -0,0 +1,107 @@
+package fetcher
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+const (
+	testETag         = `"v1"`
+	testLastModified = "Mon, 10 Nov 2025 05:30:00 GMT"
+)
+
+// 테스트용 수집기 (재시도/호스트 간격 없이)
+func newTestFetcher() *Fetcher {
+	opts := DefaultOptions()
+	opts.PerHostInterval = 0
+	opts.MaxRetries = 0
+	opts.Timeout = 5 * time.Second
+	return New(opts)
+}
+
+// ETag / Last-Modified 를 주고, 검증값이 맞으면 304 를 돌려주는 스텁 서버
+func newConditionalServer(t *testing.T) (*httptest.Server, *http.Header) {
+	t.Helper()
+	var lastHeader http.Header
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/robots.txt" {
+			http.NotFound(w, r)
+			return
+		}
+		lastHeader = r.Header.Clone()
+		if r.Header.Get("If-None-Match") == testETag || r.Header.Get("If-Modified-Since") == testLastModified {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", testETag)
+		w.Header().Set("Last-Modified", testLastModified)
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		w.Write([]byte("<p>본문</p>"))
+	}))
+	t.Cleanup(srv.Close)
+	return srv, &lastHeader
+}
+
+func TestGetConditional(t *testing.T) {
+	srv, lastHeader := newConditionalServer(t)
+	f := newTestFetcher()
+
+	tests := []struct {
+		name            string
+		validators      Validators
+		wantNotModified bool
+		wantBody        string
+		wantIfNoneMatch string
+		wantIfModSince  string
+	}{
+		{"검증값 없으면 일반 요청", Validators{}, false, "<p>본문</p>", "", ""},
+		{"ETag 일치 → 304", Validators{ETag: testETag}, true, "", testETag, ""},
+		{"Last-Modified 일치 → 304", Validators{LastModified: testLastModified}, true, "", "", testLastModified},
+		{"오래된 ETag → 200", Validators{ETag: `"v0"`}, false, "<p>본문</p>", `"v0"`, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := f.GetConditional(context.Background(), srv.URL+"/article", tt.validators)
+			if err != nil {
+				t.Fatalf("GetConditional() error = %v", err)
+			}
+			if res.NotModified != tt.wantNotModified || string(res.Body) != tt.wantBody {
+				t.Errorf("NotModified = %v, Body = %q, want %v, %q", res.NotModified, res.Body, tt.wantNotModified, tt.wantBody)
+			}
+			if got := lastHeader.Get("If-None-Match"); got != tt.wantIfNoneMatch {
+				t.Errorf("If-None-Match = %q, want %q", got, tt.wantIfNoneMatch)
+			}
+			if got := lastHeader.Get("If-Modified-Since"); got != tt.wantIfModSince {
+				t.Errorf("If-Modified-Since = %q, want %q", got, tt.wantIfModSince)
+			}
+			// 304 에 새 검증값이 없으면 보낸 값을 유지, 200 이면 응답 값
+			want := Validators{ETag: testETag, LastModified: testLastModified}
+			if tt.wantNotModified {
+				want = tt.validators
+			}
+			if res.Validators != want {
+				t.Errorf("Validators = %+v, want %+v", res.Validators, want)
+			}
+		})
+	}
+}
+
+func TestGetTreatsUnexpected304AsError(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/robots.txt" {
+			http.NotFound(w, r)
+			return
+		}
+		w.WriteHeader(http.StatusNotModified)
+	}))
+	defer srv.Close()
+
+	// 조건부 요청을 보내지 않았는데 304 가 오면 재사용할 본문이 없으므로 오류
+	if _, err := newTestFetcher().Get(context.Background(), srv.URL+"/article"); err == nil {
+		t.Fatal("Get() error = nil, want status error for unsolicited 304")
+	}
+}
@@ -0,0 +1,191 @@
+package fetcher
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// robots.txt 캐시 유지 시간
+const (
+	robotsTTL      = 24 * time.Hour
+	robotsErrorTTL = time.Hour // 조회 실패 시 빨리 다시 시도
+	robotsMaxBytes = 512 << 10 // 512KB
+)
+
+// === robots.txt 규칙 (우리 봇에 적용되는 그룹만) ===
+type robotsRules struct {
+	allow      []string
+	disallow   []string
+	crawlDelay time.Duration
+}
+
+// 모든 경로 허용 (robots.txt 가 없거나 조회 실패)
+var allowAllRobots = &robotsRules{}
+
+// 경로 허용 여부 (가장 길게 일치하는 규칙 우선, 길이가 같으면 Allow 우선)
+func (r *robotsRules) allowed(path string) bool {
+	if path == "" {
+		path = "/"
+	}
+	bestAllow, bestDisallow := -1, -1
+	for _, pattern := range r.allow {
+		if len(pattern) > bestAllow && matchRobotsPattern(pattern, path) {
+			bestAllow = len(pattern)
+		}
+	}
+	for _, pattern := range r.disallow {
+		if len(pattern) > bestDisallow && matchRobotsPattern(pattern, path) {
+			bestDisallow = len(pattern)
+		}
+	}
+	return bestDisallow < 0 || bestAllow >= bestDisallow
+}
+
+// (헬퍼 함수) robots.txt 경로 패턴 매칭 ('*' 와일드카드, '$' 끝 고정 지원)
+func matchRobotsPattern(pattern, path string) bool {
+	anchored := strings.HasSuffix(pattern, "$")
+	pattern = strings.TrimSuffix(pattern, "$")
+
+	parts := strings.Split(pattern, "*")
+	if !strings.HasPrefix(path, parts[0]) {
+		return false
+	}
+	pos := len(parts[0])
+	for _, part := range parts[1:] {
+		idx := strings.Index(path[pos:], part)
+		if idx < 0 {
+			return false
+		}
+		pos += idx + len(part)
+	}
+	if anchored {
+		return pos == len(path) || strings.HasSuffix(pattern, "*")
+	}
+	return true
+}
+
+// (내부 함수) 호스트의 robots.txt 규칙 조회 (캐시)
+func (f *Fetcher) robotsFor(ctx context.Context, u *url.URL, host *hostState) *robotsRules {
+	host.robotsMu.Lock()
+	defer host.robotsMu.Unlock()
+
+	if host.robots != nil && time.Now().Before(host.robotsExpires) {
+		return host.robots
+	}
+
+	rules, ttl := f.fetchRobots(ctx, u, host)
+	host.robots = rules
+	host.robotsExpires = time.Now().Add(ttl)
+	return rules
+}
+
+// (내부 함수) robots.txt 다운로드 및 파싱
+// 4xx(파일 없음)는 전체 허용, 네트워크 오류/5xx 도 수집이 멈추지 않도록 전체 허용 후 1시간 뒤 재시도
+func (f *Fetcher) fetchRobots(ctx context.Context, u *url.URL, host *hostState) (*robotsRules, time.Duration) {
+	robotsURL := url.URL{Scheme: u.Scheme, Host: u.Host, Path: "/robots.txt"}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, robotsURL.String(), nil)
+	if err != nil {
+		return allowAllRobots, robotsErrorTTL
+	}
+	req.Header.Set("User-Agent", f.opts.UserAgent)
+
+	if err := host.acquire(ctx, f.opts.PerHostInterval); err != nil {
+		return allowAllRobots, robotsErrorTTL
+	}
+	defer host.release()
+
+	res, err := f.client.Do(req)
+	if err != nil {
+		return allowAllRobots, robotsErrorTTL
+	}
+	defer res.Body.Close()
+
+	switch {
+	case res.StatusCode >= 200 && res.StatusCode < 300:
+		return parseRobots(io.LimitReader(res.Body, robotsMaxBytes), f.opts.UserAgent), robotsTTL
+	case res.StatusCode >= 400 && res.StatusCode < 500:
+		return allowAllRobots, robotsTTL
+	default:
+		return allowAllRobots, robotsErrorTTL
+	}
+}
+
+// === robots.txt 파싱 ===
+// 우리 봇 이름(User-Agent 의 첫 토큰)과 일치하는 그룹이 있으면 그 그룹을, 없으면 '*' 그룹을 사용
+func parseRobots(r io.Reader, userAgent string) *robotsRules {
+	botName := strings.ToLower(strings.TrimSpace(strings.SplitN(userAgent, "/", 2)[0]))
+
+	var specific, generic *robotsRules
+	var current []*robotsRules // 현재 그룹이 적용되는 대상 (여러 User-agent 줄이 연속될 수 있음)
+	inAgents := false
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if idx := strings.IndexByte(line, '#'); idx >= 0 {
+			line = line[:idx]
+		}
+		key, value, ok := strings.Cut(line, ":")
+		if !ok {
+			continue
+		}
+		key = strings.ToLower(strings.TrimSpace(key))
+		value = strings.TrimSpace(value)
+
+		if key == "user-agent" {
+			if !inAgents {
+				current = nil
+				inAgents = true
+			}
+			// 제품 토큰 전체가 대소문자 무시하고 일치할 때만 (RFC 9309, 빈 값은 무시)
+			agent := strings.ToLower(value)
+			switch {
+			case agent == "":
+			case agent == "*":
+				if generic == nil {
+					generic = &robotsRules{}
+				}
+				current = append(current, generic)
+			case botName != "" && agent == botName:
+				if specific == nil {
+					specific = &robotsRules{}
+				}
+				current = append(current, specific)
+			}
+			continue
+		}
+		inAgents = false
+
+		for _, rules := range current {
+			switch key {
+			case "allow":
+				if value != "" {
+					rules.allow = append(rules.allow, value)
+				}
+			case "disallow":
+				if value != "" {
+					rules.disallow = append(rules.disallow, value)
+				}
+			case "crawl-delay":
+				if seconds, err := strconv.ParseFloat(value, 64); err == nil && seconds > 0 {
+					rules.crawlDelay = time.Duration(seconds * float64(time.Second))
+				}
+			}
+		}
+	}
+
+	if specific != nil {
+		return specific
+	}
+	if generic != nil {
+		return generic
+	}
+	return allowAllRobots
+}
@@ -0,0 +1,87 @@
+package fetcher
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+const testUserAgent = "NewsclipBot/1.0 (+https://newsclip.app/bot)"
+
+func TestParseRobots(t *testing.T) {
+	tests := []struct {
+		name       string
+		robots     string
+		allowed    map[string]bool
+		crawlDelay time.Duration
+	}{
+		{
+			name:    "empty file allows all",
+			robots:  "",
+			allowed: map[string]bool{"/": true, "/article/1": true},
+		},
+		{
+			name:    "generic group applies when no specific group",
+			robots:  "User-agent: *\nDisallow: /private\n",
+			allowed: map[string]bool{"/": true, "/private/a": false},
+		},
+		{
+			name: "specific group overrides generic group",
+			robots: "User-agent: *\nDisallow: /\n\n" +
+				"User-agent: NewsclipBot\nDisallow: /admin\n",
+			allowed: map[string]bool{"/article/1": true, "/admin": false},
+		},
+		{
+			name:    "agent match is case-insensitive",
+			robots:  "User-agent: newsclipbot\nDisallow: /a\n",
+			allowed: map[string]bool{"/a": false, "/b": true},
+		},
+		{
+			name: "substring of bot name does not match",
+			robots: "User-agent: bot\nDisallow: /\n\n" +
+				"User-agent: Newsclip\nDisallow: /\n",
+			allowed: map[string]bool{"/": true},
+		},
+		{
+			name:    "blank user-agent is ignored",
+			robots:  "User-agent:\nDisallow: /\n",
+			allowed: map[string]bool{"/": true},
+		},
+		{
+			name: "consecutive user-agent lines share a group",
+			robots: "User-agent: Googlebot\nUser-agent: NewsclipBot\nDisallow: /shared\n\n" +
+				"User-agent: *\nDisallow: /\n",
+			allowed: map[string]bool{"/shared/x": false, "/other": true},
+		},
+		{
+			name:    "longest match wins and allow wins ties",
+			robots:  "User-agent: *\nDisallow: /news\nAllow: /news/public\nAllow: /same\nDisallow: /same\n",
+			allowed: map[string]bool{"/news/1": false, "/news/public/1": true, "/same": true},
+		},
+		{
+			name:    "wildcard and end anchor",
+			robots:  "User-agent: *\nDisallow: /*.pdf$\nDisallow: /search*q=\n",
+			allowed: map[string]bool{"/a/b.pdf": false, "/a/b.pdf?x=1": true, "/search?q=1": false, "/search": true},
+		},
+		{
+			name:       "comments and crawl-delay",
+			robots:     "# comment\nUser-agent: * # all bots\nCrawl-delay: 1.5\nDisallow: /tmp # temp\n",
+			allowed:    map[string]bool{"/tmp/a": false, "/": true},
+			crawlDelay: 1500 * time.Millisecond,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rules := parseRobots(strings.NewReader(tt.robots), testUserAgent)
+			for path, want := range tt.allowed {
+				if got := rules.allowed(path); got != want {
+					t.Errorf("allowed(%q) = %v, want %v", path, got, want)
+				}
+			}
+			if rules.crawlDelay != tt.crawlDelay {
+				t.Errorf("crawlDelay = %v, want %v", rules.crawlDelay, tt.crawlDelay)
+			}
+		})
+	}
+}
@@ -2,15 +2,34 @@ package navernews
 
 import (
 	"encoding/json"
-	"fmt"
+	"errors"
+	"io"
 	"net/http"
 	"net/url"
 	"newsclip/backend/config"
+	"strconv"
+	"strings"
 	"time"
 )
 
 const (
 	apiURL = "https://openapi.naver.com/v1/search/news.json"
+
+	// 네이버 검색 API 제한
+	maxDisplay = 100  // 한 번에 가져올 수 있는 최대 개수
+	maxStart   = 1000 // start 파라미터 최대값
+
+	defaultDailyQuota = 25000 // 검색 API 일일 호출 한도
+
+	// 초당 한도 초과(429, 012) 시 재시도
+	defaultRateLimitRetries = 3
+	defaultRateLimitBackoff = time.Second // 재시도 대기 시간 (지수 증가)
+)
+
+// 정렬 방식
+const (
+	SortSim  = "sim"  // 유사도순
+	SortDate = "date" // 날짜순 (증분 수집용)
 )
 
 // Naver API에서 반환되는 전체 응답 구조체
@@ -31,40 +50,174 @@ type NewsItem struct {
 	PubDate      string `json:"pubDate"` // (RFC 1123 format)
 }
 
+// PubDate 파싱 (예: Mon, 10 Nov 2025 14:30:00 +0900)
+func (item NewsItem) PublishedAt() (time.Time, error) {
+	return time.Parse(time.RFC1123Z, item.PubDate)
+}
+
 // API 클라이언트 구조체
 type Client struct {
+	baseURL      string
 	clientID     string
 	clientSecret string
 	httpClient   *http.Client
+	quota        *Quota
+
+	rateLimitRetries int
+	rateLimitBackoff time.Duration
+}
+
+// 클라이언트 설정 (테스트 시 로컬 스텁 서버 주소를 BaseURL 로 지정)
+type Options struct {
+	BaseURL      string
+	ClientID     string
+	ClientSecret string
+	HTTPClient   *http.Client
+	Quota        *Quota // nil 이면 프로세스 공용 일일 한도 사용
+
+	RateLimitRetries int           // 초당 한도 초과 시 재시도 횟수 (0 이면 기본값 3, 음수면 재시도 안 함)
+	RateLimitBackoff time.Duration // 초당 한도 초과 재시도 대기 시간 (0 이면 기본값 1초, 지수 증가)
 }
 
-// 새 클라이언트 생성
+// 새 클라이언트 생성 (환경 변수 사용)
+// NAVER_API_BASE_URL 로 API 주소를, NAVER_DAILY_QUOTA 로 일일 한도를 바꿀 수 있음
 func NewClient() *Client {
+	return NewClientWithOptions(Options{
+		BaseURL:      config.GetEnv("NAVER_API_BASE_URL"),
+		ClientID:     config.GetEnv("NAVER_CLIENT_ID"),
+		ClientSecret: config.GetEnv("NAVER_CLIENT_SECRET"),
+	})
+}
+
+// 설정값으로 클라이언트 생성
+func NewClientWithOptions(opts Options) *Client {
+	if opts.BaseURL == "" {
+		opts.BaseURL = apiURL
+	}
+	if opts.HTTPClient == nil {
+		opts.HTTPClient = &http.Client{Timeout: 10 * time.Second}
+	}
+	if opts.Quota == nil {
+		opts.Quota = sharedQuota()
+	}
+	if opts.RateLimitRetries == 0 {
+		opts.RateLimitRetries = defaultRateLimitRetries
+	}
+	if opts.RateLimitRetries < 0 {
+		opts.RateLimitRetries = 0
+	}
+	if opts.RateLimitBackoff <= 0 {
+		opts.RateLimitBackoff = defaultRateLimitBackoff
+	}
 	return &Client{
-		clientID:     config.GetEnv("NAVER_CLIENT_ID"),
-		clientSecret: config.GetEnv("NAVER_CLIENT_SECRET"),
-		httpClient:   &http.Client{Timeout: 10 * time.Second},
+		baseURL:          opts.BaseURL,
+		clientID:         opts.ClientID,
+		clientSecret:     opts.ClientSecret,
+		httpClient:       opts.HTTPClient,
+		quota:            opts.Quota,
+		rateLimitRetries: opts.RateLimitRetries,
+		rateLimitBackoff: opts.RateLimitBackoff,
 	}
 }
 
-// 뉴스를 검색하는 함수
+// 이 클라이언트가 사용하는 일일 호출 한도
+func (c *Client) Quota() *Quota {
+	return c.quota
+}
+
+// 뉴스를 검색하는 함수 (유사도순 1페이지)
 func (c *Client) SearchNews(query string, display int, start int) (*NaverNewsResponse, error) {
-	// 1. 요청 URL 및 쿼리 파라미터 설정
-	baseURL, _ := url.Parse(apiURL)
+	return c.search(query, display, start, SortSim)
+}
+
+// === 여러 페이지 검색 옵션 ===
+type PageOptions struct {
+	Display  int       // 페이지당 개수 (최대 100)
+	MaxPages int       // 최대 페이지 수 (수집 깊이)
+	Sort     string    // SortSim / SortDate
+	Since    time.Time // SortDate 일 때 이 시각 이전 기사가 나오면 중단 (증분 수집, 같은 시각은 중복 체크에 맡김)
+}
+
+// === 여러 페이지 검색 ===
+// 결과가 끝나거나, MaxPages 에 도달하거나, (날짜순일 때) Since 이전 기사를 만나면 중단
+// 중간 페이지에서 오류가 나면 그때까지 모은 결과와 오류를 함께 반환
+func (c *Client) SearchNewsPaged(query string, opts PageOptions) ([]NewsItem, error) {
+	if opts.Display <= 0 || opts.Display > maxDisplay {
+		opts.Display = maxDisplay
+	}
+	if opts.MaxPages <= 0 {
+		opts.MaxPages = 1
+	}
+	if opts.Sort == "" {
+		opts.Sort = SortSim
+	}
+
+	var items []NewsItem
+	for page := 0; page < opts.MaxPages; page++ {
+		start := page*opts.Display + 1
+		if start > maxStart {
+			break
+		}
+
+		resp, err := c.search(query, opts.Display, start, opts.Sort)
+		if err != nil {
+			return items, err
+		}
+
+		for _, item := range resp.Items {
+			if opts.Sort == SortDate && !opts.Since.IsZero() {
+				if pubTime, err := item.PublishedAt(); err == nil && pubTime.Before(opts.Since) {
+					return items, nil // 이미 수집한 구간에 도달
+				}
+			}
+			items = append(items, item)
+		}
+
+		if len(resp.Items) < opts.Display || start+len(resp.Items) > resp.Total {
+			break // 마지막 페이지
+		}
+	}
+	return items, nil
+}
+
+// (내부 함수) 검색 API 호출 (초당 한도 초과면 지수 백오프로 재시도)
+// 일일 한도 초과는 재시도해도 소용없으므로 바로 반환
+func (c *Client) search(query string, display int, start int, sort string) (*NaverNewsResponse, error) {
+	for attempt := 0; ; attempt++ {
+		resp, err := c.searchOnce(query, display, start, sort)
+		if err == nil || !errors.Is(err, ErrRateLimited) || attempt >= c.rateLimitRetries {
+			return resp, err
+		}
+		time.Sleep(c.rateLimitBackoff * time.Duration(1<<attempt))
+	}
+}
+
+// (내부 함수) 검색 API 1회 호출
+func (c *Client) searchOnce(query string, display int, start int, sort string) (*NaverNewsResponse, error) {
+	// 1. 일일 호출 한도 확인
+	if !c.quota.take() {
+		return nil, &APIError{StatusCode: http.StatusTooManyRequests, Message: "daily quota exhausted (local)", kind: ErrQuotaExceeded}
+	}
+
+	// 2. 요청 URL 및 쿼리 파라미터 설정
+	baseURL, err := url.Parse(c.baseURL)
+	if err != nil {
+		return nil, err
+	}
 	params := url.Values{}
 	params.Add("query", query)
-	params.Add("display", fmt.Sprintf("%d", display))
-	params.Add("start", fmt.Sprintf("%d", start))
-	params.Add("sort", "sim") // sim (유사도순), date (날짜순)
+	params.Add("display", strconv.Itoa(display))
+	params.Add("start", strconv.Itoa(start))
+	params.Add("sort", sort) // sim (유사도순), date (날짜순)
 	baseURL.RawQuery = params.Encode()
 
-	// 2. HTTP 요청 생성
+	// 3. HTTP 요청 생성
 	req, err := http.NewRequest("GET", baseURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	// 3. (중요) Naver API는 헤더에 인증 정보를 담아 보냅니다.
+	// (중요) Naver API는 헤더에 인증 정보를 담아 보냅니다.
 	req.Header.Set("X-Naver-Client-Id", c.clientID)
 	req.Header.Set("X-Naver-Client-Secret", c.clientSecret)
 
@@ -76,7 +229,11 @@ func (c *Client) SearchNews(query string, display int, start int) (*NaverNewsRes
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("네이버 API 요청 실패: %s", resp.Status)
+		apiErr := parseAPIError(resp)
+		if apiErr.kind == ErrQuotaExceeded {
+			c.quota.exhaust() // 서버가 일일 한도 초과를 알리면 오늘은 더 호출하지 않음
+		}
+		return nil, apiErr
 	}
 
 	// 5. 응답 JSON 파싱
@@ -87,3 +244,37 @@ func (c *Client) SearchNews(query string, display int, start int) (*NaverNewsRes
 
 	return &response, nil
 }
+
+// (헬퍼 함수) 오류 응답 파싱 ({"errorMessage": "...", "errorCode": "SE01"})
+func parseAPIError(resp *http.Response) *APIError {
+	var body struct {
+		ErrorMessage string `json:"errorMessage"`
+		ErrorCode    string `json:"errorCode"`
+	}
+	data, _ := io.ReadAll(io.LimitReader(resp.Body, 64<<10))
+	_ = json.Unmarshal(data, &body)
+
+	apiErr := &APIError{
+		StatusCode: resp.StatusCode,
+		Code:       body.ErrorCode,
+		Message:    body.ErrorMessage,
+	}
+	if apiErr.Message == "" {
+		apiErr.Message = strings.TrimSpace(string(data))
+	}
+
+	switch {
+	case resp.StatusCode == http.StatusUnauthorized || resp.StatusCode == http.StatusForbidden:
+		apiErr.kind = ErrAuth
+	case resp.StatusCode == http.StatusTooManyRequests && apiErr.Code == errorCodeQuotaExceeded:
+		apiErr.kind = ErrQuotaExceeded
+	case resp.StatusCode == http.StatusTooManyRequests:
+		// 초당 한도 초과(012) 및 알 수 없는 429 는 일시적인 것으로 보고 재시도 (일일 한도는 소진하지 않음)
+		apiErr.kind = ErrRateLimited
+	case resp.StatusCode >= 500:
+		apiErr.kind = ErrServer
+	default:
+		apiErr.kind = ErrBadRequest
+	}
+	return apiErr
+}
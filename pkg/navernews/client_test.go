@@ -0,0 +1,291 @@
+package navernews
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+var stubBaseTime = time.Date(2025, 11, 10, 12, 0, 0, 0, quotaLocation)
+
+// (헬퍼 함수) 총 total 건의 기사를 1시간 간격 최신순으로 돌려주는 검색 API 스텁
+func newSearchStub(t *testing.T, total int) (*httptest.Server, *int32) {
+	t.Helper()
+	var requests int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		if r.Header.Get("X-Naver-Client-Id") != "id" || r.Header.Get("X-Naver-Client-Secret") != "secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			fmt.Fprint(w, `{"errorMessage":"Authentication failed","errorCode":"024"}`)
+			return
+		}
+
+		q := r.URL.Query()
+		display, _ := strconv.Atoi(q.Get("display"))
+		start, _ := strconv.Atoi(q.Get("start"))
+		if q.Get("query") != "경제" {
+			t.Errorf("query = %q, want 경제", q.Get("query"))
+		}
+
+		resp := NaverNewsResponse{Total: total, Start: start}
+		for i := start - 1; i < total && i < start-1+display; i++ {
+			resp.Items = append(resp.Items, NewsItem{
+				Title:   fmt.Sprintf("기사 %d", i),
+				Link:    fmt.Sprintf("https://n.news.naver.com/article/%d", i),
+				PubDate: stubBaseTime.Add(-time.Duration(i) * time.Hour).Format(time.RFC1123Z),
+			})
+		}
+		resp.Display = len(resp.Items)
+		json.NewEncoder(w).Encode(resp)
+	}))
+	t.Cleanup(srv.Close)
+	return srv, &requests
+}
+
+func newStubClient(baseURL string, quota *Quota) *Client {
+	return NewClientWithOptions(Options{
+		BaseURL:      baseURL,
+		ClientID:     "id",
+		ClientSecret: "secret",
+		Quota:        quota,
+
+		RateLimitBackoff: time.Millisecond,
+	})
+}
+
+func TestSearchNewsPaged(t *testing.T) {
+	tests := []struct {
+		name         string
+		total        int
+		opts         PageOptions
+		wantItems    int
+		wantRequests int32
+	}{
+		{"마지막 페이지에서 중단", 250, PageOptions{Display: 100, MaxPages: 5}, 250, 3},
+		{"MaxPages 에서 중단", 250, PageOptions{Display: 100, MaxPages: 2}, 200, 2},
+		{"total 이 페이지 크기의 배수", 200, PageOptions{Display: 100, MaxPages: 5}, 200, 2},
+		{"결과 없음", 0, PageOptions{Display: 100, MaxPages: 5}, 0, 1},
+		{"Display 기본값 100", 150, PageOptions{MaxPages: 5}, 150, 2},
+		{
+			"날짜순 Since 이전 기사에서 중단 (같은 시각은 포함)", 500,
+			PageOptions{Display: 100, MaxPages: 5, Sort: SortDate, Since: stubBaseTime.Add(-150 * time.Hour)},
+			151, 2,
+		},
+		{
+			"유사도순은 Since 무시", 250,
+			PageOptions{Display: 100, MaxPages: 5, Sort: SortSim, Since: stubBaseTime.Add(-10 * time.Hour)},
+			250, 3,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv, requests := newSearchStub(t, tt.total)
+			client := newStubClient(srv.URL, NewQuota(0))
+
+			items, err := client.SearchNewsPaged("경제", tt.opts)
+			if err != nil {
+				t.Fatalf("SearchNewsPaged() error = %v", err)
+			}
+			if len(items) != tt.wantItems {
+				t.Errorf("len(items) = %d, want %d", len(items), tt.wantItems)
+			}
+			if got := atomic.LoadInt32(requests); got != tt.wantRequests {
+				t.Errorf("requests = %d, want %d", got, tt.wantRequests)
+			}
+			for i, item := range items {
+				if want := fmt.Sprintf("기사 %d", i); item.Title != want {
+					t.Fatalf("items[%d].Title = %q, want %q", i, item.Title, want)
+				}
+			}
+		})
+	}
+}
+
+func TestSearchNewsPagedStopsAtStartLimit(t *testing.T) {
+	srv, requests := newSearchStub(t, 5000)
+	client := newStubClient(srv.URL, NewQuota(0))
+
+	items, err := client.SearchNewsPaged("경제", PageOptions{Display: 100, MaxPages: 20})
+	if err != nil {
+		t.Fatalf("SearchNewsPaged() error = %v", err)
+	}
+	// start 는 최대 1000 까지 (901 페이지가 마지막)
+	if len(items) != 1000 || atomic.LoadInt32(requests) != 10 {
+		t.Errorf("got %d items in %d requests, want 1000 items in 10 requests", len(items), atomic.LoadInt32(requests))
+	}
+}
+
+func TestSearchNewsPagedLocalQuota(t *testing.T) {
+	srv, requests := newSearchStub(t, 500)
+	quota := NewQuota(2)
+	client := newStubClient(srv.URL, quota)
+
+	items, err := client.SearchNewsPaged("경제", PageOptions{Display: 100, MaxPages: 5})
+	if !errors.Is(err, ErrQuotaExceeded) {
+		t.Fatalf("error = %v, want ErrQuotaExceeded", err)
+	}
+	if len(items) != 200 {
+		t.Errorf("len(items) = %d, want 200 (pages fetched before the quota ran out)", len(items))
+	}
+	if got := atomic.LoadInt32(requests); got != 2 {
+		t.Errorf("requests = %d, want 2", got)
+	}
+	if usage := quota.Usage(); usage.Used != 2 || usage.Remaining != 0 {
+		t.Errorf("usage = %+v, want used 2, remaining 0", usage)
+	}
+}
+
+func TestSearchServerQuotaExhaustsLocalQuota(t *testing.T) {
+	var requests int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		w.WriteHeader(http.StatusTooManyRequests)
+		fmt.Fprint(w, `{"errorMessage":"Query limit exceeded","errorCode":"010"}`)
+	}))
+	defer srv.Close()
+
+	quota := NewQuota(100)
+	client := newStubClient(srv.URL, quota)
+
+	if _, err := client.SearchNews("경제", 10, 1); !errors.Is(err, ErrQuotaExceeded) {
+		t.Fatalf("first call error = %v, want ErrQuotaExceeded", err)
+	}
+	if usage := quota.Usage(); usage.Remaining != 0 {
+		t.Errorf("remaining = %d, want 0 after server 429", usage.Remaining)
+	}
+
+	// 오늘은 더 이상 서버를 호출하지 않음
+	if _, err := client.SearchNews("경제", 10, 1); !errors.Is(err, ErrQuotaExceeded) {
+		t.Fatalf("second call error = %v, want ErrQuotaExceeded", err)
+	}
+	if got := atomic.LoadInt32(&requests); got != 1 {
+		t.Errorf("requests = %d, want 1", got)
+	}
+}
+
+func TestSearchRetriesRateLimit(t *testing.T) {
+	tests := []struct {
+		name         string
+		throttled    int32 // 처음 몇 번 429(012)를 돌려줄지
+		wantErr      error
+		wantRequests int32
+	}{
+		{"일시적인 초당 한도 초과는 재시도 후 성공", 2, nil, 3},
+		{"재시도 횟수를 넘기면 ErrRateLimited", 100, ErrRateLimited, 4},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var requests int32
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if atomic.AddInt32(&requests, 1) <= tt.throttled {
+					w.WriteHeader(http.StatusTooManyRequests)
+					fmt.Fprint(w, `{"errorMessage":"Rate limit exceeded","errorCode":"012"}`)
+					return
+				}
+				json.NewEncoder(w).Encode(NaverNewsResponse{Total: 1, Start: 1, Display: 1, Items: []NewsItem{{Title: "기사"}}})
+			}))
+			defer srv.Close()
+
+			quota := NewQuota(100)
+			resp, err := newStubClient(srv.URL, quota).SearchNews("경제", 10, 1)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) || errors.Is(err, ErrQuotaExceeded) {
+					t.Fatalf("error = %v, want %v (not ErrQuotaExceeded)", err, tt.wantErr)
+				}
+			} else if err != nil || len(resp.Items) != 1 {
+				t.Fatalf("SearchNews() = %+v, %v, want 1 item", resp, err)
+			}
+			if got := atomic.LoadInt32(&requests); got != tt.wantRequests {
+				t.Errorf("requests = %d, want %d", got, tt.wantRequests)
+			}
+			// 초당 한도 초과는 일일 한도를 소진하지 않음 (시도한 만큼만 차감)
+			if usage := quota.Usage(); usage.Used != int(tt.wantRequests) {
+				t.Errorf("used = %d, want %d", usage.Used, tt.wantRequests)
+			}
+		})
+	}
+}
+
+func TestSearchErrorMapping(t *testing.T) {
+	tests := []struct {
+		name        string
+		status      int
+		body        string
+		wantKind    error
+		wantCode    string
+		wantMessage string
+	}{
+		{"401 인증 실패", http.StatusUnauthorized, `{"errorMessage":"Authentication failed","errorCode":"024"}`, ErrAuth, "024", "Authentication failed"},
+		{"403 권한 없음", http.StatusForbidden, `{"errorMessage":"Forbidden","errorCode":"SE05"}`, ErrAuth, "SE05", "Forbidden"},
+		{"400 잘못된 쿼리", http.StatusBadRequest, `{"errorMessage":"Incorrect query request","errorCode":"SE01"}`, ErrBadRequest, "SE01", "Incorrect query request"},
+		{"404 잘못된 경로", http.StatusNotFound, `not found`, ErrBadRequest, "", "not found"},
+		{"429 일일 한도 초과", http.StatusTooManyRequests, `{"errorMessage":"Query limit exceeded","errorCode":"010"}`, ErrQuotaExceeded, "010", "Query limit exceeded"},
+		{"500 서버 오류", http.StatusInternalServerError, `{"errorMessage":"System error","errorCode":"SE99"}`, ErrServer, "SE99", "System error"},
+		{"503 본문 없음", http.StatusServiceUnavailable, ``, ErrServer, "", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tt.status)
+				fmt.Fprint(w, tt.body)
+			}))
+			defer srv.Close()
+
+			quota := NewQuota(100)
+			_, err := newStubClient(srv.URL, quota).SearchNews("경제", 10, 1)
+
+			var apiErr *APIError
+			if !errors.As(err, &apiErr) {
+				t.Fatalf("error = %v, want *APIError", err)
+			}
+			if !errors.Is(err, tt.wantKind) {
+				t.Errorf("errors.Is(%v) = false, want kind %v", err, tt.wantKind)
+			}
+			if apiErr.StatusCode != tt.status || apiErr.Code != tt.wantCode || apiErr.Message != tt.wantMessage {
+				t.Errorf("APIError = %+v, want status %d, code %q, message %q", apiErr, tt.status, tt.wantCode, tt.wantMessage)
+			}
+			// 일일 한도 초과만 남은 한도를 소진
+			wantUsed := 1
+			if tt.wantKind == ErrQuotaExceeded {
+				wantUsed = 100
+			}
+			if usage := quota.Usage(); usage.Used != wantUsed {
+				t.Errorf("used = %d, want %d", usage.Used, wantUsed)
+			}
+		})
+	}
+}
+
+func TestSearchNewsPagedReturnsItemsBeforeError(t *testing.T) {
+	var requests int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&requests, 1) > 1 {
+			w.WriteHeader(http.StatusInternalServerError)
+			fmt.Fprint(w, `{"errorMessage":"System error","errorCode":"SE99"}`)
+			return
+		}
+		resp := NaverNewsResponse{Total: 300, Start: 1, Display: 100}
+		for i := 0; i < 100; i++ {
+			resp.Items = append(resp.Items, NewsItem{Title: strconv.Itoa(i), PubDate: stubBaseTime.Format(time.RFC1123Z)})
+		}
+		json.NewEncoder(w).Encode(resp)
+	}))
+	defer srv.Close()
+
+	items, err := newStubClient(srv.URL, NewQuota(0)).SearchNewsPaged("경제", PageOptions{Display: 100, MaxPages: 3})
+	if !errors.Is(err, ErrServer) {
+		t.Fatalf("error = %v, want ErrServer", err)
+	}
+	if len(items) != 100 {
+		t.Errorf("len(items) = %d, want 100", len(items))
+	}
+}
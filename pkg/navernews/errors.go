@@ -0,0 +1,39 @@
+package navernews
+
+import (
+	"errors"
+	"fmt"
+)
+
+// === 오류 종류 (errors.Is 로 구분) ===
+var (
+	ErrAuth          = errors.New("naver api: 인증 실패 (Client ID/Secret 또는 권한 확인)")
+	ErrQuotaExceeded = errors.New("naver api: 일일 호출 한도 초과")
+	ErrRateLimited   = errors.New("naver api: 초당 호출 한도 초과 (잠시 후 재시도)")
+	ErrServer        = errors.New("naver api: 서버 오류")
+	ErrBadRequest    = errors.New("naver api: 잘못된 요청")
+)
+
+// 네이버 오류 코드 (429 응답 구분용)
+const (
+	errorCodeQuotaExceeded = "010" // 일일 사용 한도 초과 (한국 시간 자정에 초기화)
+	errorCodeRateLimited   = "012" // 처리율(초당) 한도 초과
+)
+
+// === API 오류 응답 ===
+type APIError struct {
+	StatusCode int
+	Code       string // 네이버 오류 코드 (예: SE01, SE99)
+	Message    string
+
+	kind error // 위 오류 종류 중 하나
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("네이버 API 요청 실패 (%d %s): %s", e.StatusCode, e.Code, e.Message)
+}
+
+// errors.Is(err, ErrQuotaExceeded) 등으로 분기할 수 있도록 종류를 반환
+func (e *APIError) Unwrap() error {
+	return e.kind
+}
@@ -0,0 +1,90 @@
+package navernews
+
+import (
+	"newsclip/backend/config"
+	"sync"
+	"time"
+)
+
+// 네이버 API 일일 한도는 한국 시간 자정에 초기화
+var quotaLocation = time.FixedZone("KST", 9*60*60)
+
+// === 일일 호출 한도 추적 (프로세스 단위) ===
+type Quota struct {
+	mu    sync.Mutex
+	limit int
+	day   string // 사용량을 센 날짜 (KST, YYYY-MM-DD)
+	used  int
+}
+
+// 한도 사용 현황
+type QuotaUsage struct {
+	Day       string `json:"day"`
+	Limit     int    `json:"limit"`
+	Used      int    `json:"used"`
+	Remaining int    `json:"remaining"`
+}
+
+// 새 한도 추적기 생성
+func NewQuota(limit int) *Quota {
+	return &Quota{limit: limit}
+}
+
+var (
+	sharedQuotaOnce sync.Once
+	sharedQuotaVal  *Quota
+)
+
+// 프로세스 공용 한도 (NAVER_DAILY_QUOTA, 기본 25,000회)
+func sharedQuota() *Quota {
+	sharedQuotaOnce.Do(func() {
+		sharedQuotaVal = NewQuota(int(config.GetEnvFloat("NAVER_DAILY_QUOTA", defaultDailyQuota)))
+	})
+	return sharedQuotaVal
+}
+
+// 현재 사용 현황
+func (q *Quota) Usage() QuotaUsage {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.rollover()
+
+	remaining := q.limit - q.used
+	if remaining < 0 {
+		remaining = 0
+	}
+	return QuotaUsage{Day: q.day, Limit: q.limit, Used: q.used, Remaining: remaining}
+}
+
+// 호출 1회 차감 (한도 초과면 false)
+func (q *Quota) take() bool {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.rollover()
+
+	if q.limit > 0 && q.used >= q.limit {
+		return false
+	}
+	q.used++
+	return true
+}
+
+// 오늘 남은 한도를 모두 소진 처리 (서버가 429를 반환한 경우)
+func (q *Quota) exhaust() {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.rollover()
+
+	if q.limit > 0 {
+		q.used = q.limit
+	}
+}
+
+// (내부 함수) 날짜가 바뀌면 사용량 초기화 (호출자가 잠금 보유)
+func (q *Quota) rollover() {
+	today := time.Now().In(quotaLocation).Format("2006-01-02")
+	if q.day != today {
+		q.day = today
+		q.used = 0
+	}
+}
@@ -0,0 +1,75 @@
+package readtime
+
+import (
+	"html"
+	"math"
+	"regexp"
+	"unicode"
+)
+
+// === 읽기 속도 ===
+// 한글/한자/가나는 글자 수(공백 제외)로, 영문/숫자는 단어 수로 따로 계산한 뒤 합산
+// (한국어 성인 묵독 속도 약 500자/분, 영문 약 230단어/분)
+type Speed struct {
+	CharsPerMinute float64 // 한글 등 글자 단위 문자 (분당 글자 수)
+	WordsPerMinute float64 // 영문/숫자 (분당 단어 수)
+}
+
+var DefaultSpeed = Speed{
+	CharsPerMinute: 500,
+	WordsPerMinute: 230,
+}
+
+var htmlTagPattern = regexp.MustCompile(`<[^>]*>`)
+
+// === 글자/단어 수 집계 ===
+//   - chars: 한글/한자/가나 글자 수
+//   - words: 영문/숫자 단어 수 (연속된 글자/숫자 묶음)
+func Count(text string) (chars int, words int) {
+	text = html.UnescapeString(htmlTagPattern.ReplaceAllString(text, " "))
+
+	inWord := false
+	for _, r := range text {
+		switch {
+		case isCharUnit(r):
+			chars++
+			inWord = false
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			if !inWord {
+				words++
+				inWord = true
+			}
+		case r == '\'' || r == '’' || r == '.' || r == ',' || r == '-':
+			// "don't", "3.5", "1,000", "e-mail" 은 한 단어로 유지
+		default:
+			inWord = false
+		}
+	}
+	return chars, words
+}
+
+// === 예상 읽기 시간 (분, 반올림, 최소 1분) ===
+// 본문이 비어 있으면 0 반환
+func Minutes(text string, speed Speed) int {
+	chars, words := Count(text)
+	if chars == 0 && words == 0 {
+		return 0
+	}
+	if speed.CharsPerMinute <= 0 {
+		speed.CharsPerMinute = DefaultSpeed.CharsPerMinute
+	}
+	if speed.WordsPerMinute <= 0 {
+		speed.WordsPerMinute = DefaultSpeed.WordsPerMinute
+	}
+
+	minutes := float64(chars)/speed.CharsPerMinute + float64(words)/speed.WordsPerMinute
+	if minutes < 1 {
+		return 1
+	}
+	return int(math.Round(minutes))
+}
+
+// (헬퍼 함수) 글자 단위로 읽는 문자인지 (한글/한자/가나)
+func isCharUnit(r rune) bool {
+	return unicode.In(r, unicode.Hangul, unicode.Han, unicode.Hiragana, unicode.Katakana)
+}
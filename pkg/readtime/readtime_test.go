@@ -0,0 +1,64 @@
+package readtime
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestCount(t *testing.T) {
+	tests := []struct {
+		name      string
+		text      string
+		wantChars int
+		wantWords int
+	}{
+		{"빈 문자열", "", 0, 0},
+		{"공백만", " \n\t ", 0, 0},
+		{"한글 (공백/문장부호 제외)", "안녕하세요, 뉴스입니다.", 10, 0},
+		{"영문 단어", "The quick brown fox", 0, 4},
+		{"아포스트로피/소수점/천 단위/하이픈은 한 단어", "don't 3.5 1,000 e-mail", 0, 4},
+		{"한글과 영문 혼합", "삼성전자 AI 반도체 HBM3E 출시", 9, 2},
+		{"한글 바로 뒤 영문은 새 단어", "갤럭시S25", 3, 1},
+		{"HTML 태그 제거", "<p>한국</p><b>Korea</b>", 2, 1},
+		{"태그 사이는 단어 구분", "<p>one</p><p>two</p>", 0, 2},
+		{"HTML 엔티티 변환", "A&amp;B &lt;뉴스&gt;", 2, 2},
+		{"한자/가나", "漢字 かな カナ", 6, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			chars, words := Count(tt.text)
+			if chars != tt.wantChars || words != tt.wantWords {
+				t.Errorf("Count(%q) = (%d, %d), want (%d, %d)", tt.text, chars, words, tt.wantChars, tt.wantWords)
+			}
+		})
+	}
+}
+
+func TestMinutes(t *testing.T) {
+	tests := []struct {
+		name  string
+		text  string
+		speed Speed
+		want  int
+	}{
+		{"빈 본문은 0분", "", DefaultSpeed, 0},
+		{"태그만 있는 본문은 0분", "<br/><p></p>", DefaultSpeed, 0},
+		{"짧은 본문은 최소 1분", "짧은 기사", DefaultSpeed, 1},
+		{"한글 1,000자 = 2분", strings.Repeat("가", 1000), DefaultSpeed, 2},
+		{"한글 1,200자 = 2.4분 → 2분", strings.Repeat("가", 1200), DefaultSpeed, 2},
+		{"한글 1,250자 = 2.5분 → 3분", strings.Repeat("가", 1250), DefaultSpeed, 3},
+		{"영문 460단어 = 2분", strings.Repeat("word ", 460), DefaultSpeed, 2},
+		{"한글 500자 + 영문 230단어 = 2분", strings.Repeat("가", 500) + strings.Repeat(" word", 230), DefaultSpeed, 2},
+		{"사용자 지정 속도", strings.Repeat("가", 1000), Speed{CharsPerMinute: 250, WordsPerMinute: 100}, 4},
+		{"0 이하 속도는 기본값 사용", strings.Repeat("가", 1000), Speed{}, 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Minutes(tt.text, tt.speed); got != tt.want {
+				t.Errorf("Minutes() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
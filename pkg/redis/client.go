@@ -32,6 +32,12 @@ func SetData(key string, value interface{}, duration time.Duration) error {
 	return Client.Set(Ctx, key, value, duration).Err()
 }
 
+// 키가 없을 때만 저장 (유효시간 포함)
+// 저장에 성공하면 true, 이미 키가 존재하면 false
+func SetDataIfNotExists(key string, value interface{}, duration time.Duration) (bool, error) {
+	return Client.SetNX(Ctx, key, value, duration).Result()
+}
+
 // 데이터 조회
 func GetData(key string) (string, error) {
 	return Client.Get(Ctx, key).Result()
@@ -0,0 +1,174 @@
+package tagger
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+	"unicode/utf8"
+)
+
+// === 개체명 인식 (사전 + 패턴) ===
+// 형태소 분석기 없이 동작하도록
+//   - 인물: "성+이름(3글자) + 직함" 패턴 (예: "홍길동 장관")
+//   - 기관: 사전 + 기관 접미사 (예: "~위원회", "~은행", "~전자")
+//   - 장소: 국내 시·도 / 주요 국가 사전
+
+// 인물 뒤에 오는 직함 ("기자"는 기사 작성자이므로 제외)
+var titles = []string{
+	"대통령", "국무총리", "총리", "부총리", "장관", "차관", "의원", "원내대표", "대표", "위원장", "부위원장",
+	"회장", "부회장", "사장", "대표이사", "감독", "선수", "교수", "시장", "도지사", "지사", "청장", "총장",
+	"대변인", "후보", "비서실장", "실장", "국무장관", "주석", "총재", "판사", "검사", "변호사", "씨",
+}
+
+var (
+	personPattern = regexp.MustCompile(`(?:^|[^가-힣])([가-힣]{3})\s*(?:전\s*)?(?:` + strings.Join(titles, "|") + `)`)
+	personTitles  = toBoolSet(titles) // 직함 자체는 키워드에서 제외
+)
+
+// 한국인 성씨 (인물 패턴 오탐 방지)
+var surnames = "김이박최정강조윤장임한오서신권황안송류유홍전고문양손배백허남심노하곽성차주우구민진나엄채원천방공현함변염여추도소석선설마길연위표명기반왕금옥육인맹제모탁국어은편용예경봉"
+
+// 기관 접미사 (이 접미사로 끝나는 3글자 이상 단어를 기관으로 인식)
+var organizationSuffixes = []string{
+	"위원회", "공사", "공단", "그룹", "은행", "증권", "대학교", "협회", "재단", "연구원", "연구소",
+	"법원", "검찰청", "경찰청", "전자", "자동차", "보험", "중공업", "금융지주", "홀딩스", "조합", "연합회",
+}
+
+// 기관 사전 (접미사로 찾기 어려운 정당/부처/기업)
+var knownOrganizations = []string{
+	"더불어민주당", "민주당", "국민의힘", "조국혁신당", "개혁신당", "정의당", "진보당",
+	"대통령실", "국회", "정부", "헌법재판소", "대법원", "감사원", "국정원", "한국은행", "금융감독원", "공정거래위원회",
+	"기획재정부", "교육부", "과학기술정보통신부", "외교부", "통일부", "법무부", "국방부", "행정안전부", "문화체육관광부",
+	"농림축산식품부", "산업통상자원부", "보건복지부", "환경부", "고용노동부", "여성가족부", "국토교통부", "해양수산부", "중소벤처기업부",
+	"삼성", "현대차", "기아", "SK하이닉스", "LG에너지솔루션", "포스코", "네이버", "카카오", "쿠팡", "배달의민족", "하이브",
+	"애플", "구글", "테슬라", "엔비디아", "마이크로소프트", "오픈AI", "메타", "아마존", "TSMC", "인텔",
+	"유엔", "UN", "나토", "NATO", "WHO", "IMF", "OECD", "연준", "FIFA", "IOC",
+}
+
+// 장소 사전
+var knownPlaces = []string{
+	"서울", "부산", "대구", "인천", "광주", "대전", "울산", "세종", "경기", "경기도", "강원", "강원도",
+	"충북", "충남", "전북", "전남", "경북", "경남", "제주", "제주도", "수도권",
+	"미국", "중국", "일본", "러시아", "북한", "우크라이나", "영국", "프랑스", "독일", "이탈리아", "캐나다", "호주",
+	"인도", "베트남", "대만", "홍콩", "이스라엘", "이란", "사우디아라비아", "튀르키예", "유럽", "중동", "동남아", "브라질", "멕시코",
+	"워싱턴", "베이징", "도쿄", "모스크바", "평양", "가자지구",
+}
+
+var (
+	organizationSet = toSet(knownOrganizations)
+	placeSet        = toSet(knownPlaces)
+)
+
+func toSet(values []string) map[string]string {
+	set := make(map[string]string, len(values))
+	for _, v := range values {
+		set[strings.ToLower(v)] = v
+	}
+	return set
+}
+
+func toBoolSet(values []string) map[string]bool {
+	set := make(map[string]bool, len(values))
+	for _, v := range values {
+		set[v] = true
+	}
+	return set
+}
+
+// (내부 함수) 사전에 있는 개체명인지 (조사 제거 전 확인용)
+func isKnownEntity(word string) bool {
+	lower := strings.ToLower(word)
+	_, org := organizationSet[lower]
+	_, place := placeSet[lower]
+	return org || place
+}
+
+// (내부 함수) 문서에서 개체명 추출 (등장 횟수 기준 상위 maxEntities 개)
+func extractEntities(doc Document, maxEntities int) []Tag {
+	type entity struct {
+		name  string
+		typ   string
+		count float64
+	}
+	found := make(map[string]*entity)
+	add := func(name, typ string, weight float64) {
+		key := typ + ":" + strings.ToLower(name)
+		if e, ok := found[key]; ok {
+			e.count += weight
+			return
+		}
+		found[key] = &entity{name: name, typ: typ, count: weight}
+	}
+
+	scan := func(text string, weight float64) {
+		text = cleanText(text)
+
+		// 1. 인물 (성씨로 시작하는 3글자 + 직함)
+		for _, m := range personPattern.FindAllStringSubmatch(text, -1) {
+			name := m[1]
+			first, _ := utf8.DecodeRuneInString(name)
+			if strings.ContainsRune(surnames, first) && !isKnownEntity(name) {
+				add(name, TypePerson, weight)
+			}
+		}
+
+		// 2. 기관/장소 (어절 단위)
+		for _, w := range splitWords(text) {
+			lower := strings.ToLower(w)
+			if display, ok := organizationSet[lower]; ok {
+				add(display, TypeOrganization, weight)
+				continue
+			}
+			if display, ok := placeSet[lower]; ok {
+				add(display, TypePlace, weight)
+				continue
+			}
+
+			stem := stripParticle(w)
+			lowerStem := strings.ToLower(stem)
+			if display, ok := organizationSet[lowerStem]; ok {
+				add(display, TypeOrganization, weight)
+			} else if display, ok := placeSet[lowerStem]; ok {
+				add(display, TypePlace, weight)
+			} else if hasOrganizationSuffix(stem) {
+				add(stem, TypeOrganization, weight)
+			}
+		}
+	}
+	scan(doc.Title, titleWeight)
+	scan(truncateRunes(doc.Body, maxBodyRunes), 1)
+
+	entities := make([]*entity, 0, len(found))
+	for _, e := range found {
+		entities = append(entities, e)
+	}
+	sort.Slice(entities, func(i, j int) bool {
+		if entities[i].count != entities[j].count {
+			return entities[i].count > entities[j].count
+		}
+		return entities[i].name < entities[j].name
+	})
+	if len(entities) > maxEntities {
+		entities = entities[:maxEntities]
+	}
+
+	tags := make([]Tag, len(entities))
+	for i, e := range entities {
+		tags[i] = Tag{Name: e.name, Type: e.typ, Score: e.count}
+	}
+	normalizeScores(tags)
+	return tags
+}
+
+// (헬퍼 함수) 기관 접미사로 끝나는 3글자 이상 단어인지
+func hasOrganizationSuffix(word string) bool {
+	if utf8.RuneCountInString(word) < 3 {
+		return false
+	}
+	for _, suffix := range organizationSuffixes {
+		if strings.HasSuffix(word, suffix) && word != suffix {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,290 @@
+package tagger
+
+import (
+	"html"
+	"math"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// 태그 종류
+const (
+	TypeKeyword      = "keyword"
+	TypePerson       = "person"
+	TypeOrganization = "organization"
+	TypePlace        = "place"
+)
+
+// 문서 1개당 분석에 사용하는 최대 글자 수 (본문 전체를 쓰면 계산량이 커짐)
+const maxBodyRunes = 3000
+
+// 제목에 등장한 단어 가중치 (제목이 주제를 가장 잘 나타냄)
+const titleWeight = 3.0
+
+// 키워드 후보 최소 빈도 (제목 가중치 포함)
+const minKeywordFrequency = 2.0
+
+// === 입력 문서 ===
+type Document struct {
+	Title string
+	Body  string
+}
+
+// === 추출된 태그 ===
+type Tag struct {
+	Name  string
+	Type  string
+	Score float64 // 문서 내 상대 중요도 (0~1, 가장 중요한 태그가 1)
+}
+
+// === 말뭉치 (IDF 계산용) ===
+// 최근 기사들로 단어별 문서 빈도를 세어 두고, 기사마다 흔하지 않으면서 자주 등장하는 단어를 키워드로 선택
+type Corpus struct {
+	docs int
+	df   map[string]int
+}
+
+var htmlTagPattern = regexp.MustCompile(`<[^>]*>`)
+
+// 말뭉치 생성
+func NewCorpus(docs []Document) *Corpus {
+	c := &Corpus{df: make(map[string]int)}
+	for _, doc := range docs {
+		seen := make(map[string]bool)
+		for _, term := range docTerms(doc) {
+			if !seen[term.text] {
+				seen[term.text] = true
+				c.df[term.text]++
+			}
+		}
+		c.docs++
+	}
+	return c
+}
+
+// === 문서 태그 추출 ===
+// 개체명(인물/기관/장소)을 먼저 찾고, 나머지 상위 TF-IDF 단어/구를 키워드로 추가
+func (c *Corpus) Tags(doc Document, maxKeywords int, maxEntities int) []Tag {
+	entities := extractEntities(doc, maxEntities)
+
+	// 개체명으로 뽑힌 단어는 키워드에서 제외
+	taken := make(map[string]bool, len(entities))
+	for _, e := range entities {
+		taken[strings.ToLower(e.Name)] = true
+	}
+
+	// 1. 문서 내 단어 빈도 (제목 가중치)
+	tf := make(map[string]float64)
+	display := make(map[string]string)
+	for _, term := range docTerms(doc) {
+		w := 1.0
+		if term.inTitle {
+			w = titleWeight
+		}
+		tf[term.text] += w
+		if _, ok := display[term.text]; !ok {
+			display[term.text] = term.display
+		}
+	}
+
+	// 2. TF-IDF 점수
+	type scored struct {
+		term  string
+		score float64
+	}
+	candidates := make([]scored, 0, len(tf))
+	n := float64(c.docs)
+	for term, freq := range tf {
+		if taken[term] {
+			continue
+		}
+		// 제목에 있거나 본문에 2번 이상 나온 단어/구만 키워드 후보로 인정
+		if freq < minKeywordFrequency {
+			continue
+		}
+		idf := math.Log((n+1)/(float64(c.df[term])+1)) + 1
+		candidates = append(candidates, scored{term, (1 + math.Log(freq)) * idf})
+	}
+	sort.Slice(candidates, func(i, j int) bool {
+		if candidates[i].score != candidates[j].score {
+			return candidates[i].score > candidates[j].score
+		}
+		return candidates[i].term < candidates[j].term
+	})
+
+	// 3. 상위 키워드 선택 (이미 뽑힌 구에 포함된 단어는 중복으로 보고 제외)
+	var keywords []Tag
+	for _, cand := range candidates {
+		if len(keywords) >= maxKeywords {
+			break
+		}
+		if overlapsSelected(cand.term, keywords) {
+			continue
+		}
+		keywords = append(keywords, Tag{Name: display[cand.term], Type: TypeKeyword, Score: cand.score})
+	}
+	normalizeScores(keywords)
+
+	return append(entities, keywords...)
+}
+
+// (헬퍼 함수) 이미 선택된 키워드와 겹치는지 (구 ↔ 단어)
+func overlapsSelected(term string, selected []Tag) bool {
+	for _, t := range selected {
+		name := strings.ToLower(t.Name)
+		if strings.Contains(name, term) || strings.Contains(term, name) {
+			return true
+		}
+	}
+	return false
+}
+
+// (헬퍼 함수) 최고 점수를 1로 맞춤
+func normalizeScores(tags []Tag) {
+	max := 0.0
+	for _, t := range tags {
+		if t.Score > max {
+			max = t.Score
+		}
+	}
+	if max == 0 {
+		return
+	}
+	for i := range tags {
+		tags[i].Score = math.Round(tags[i].Score/max*1000) / 1000
+	}
+}
+
+// === 단어 추출 ===
+
+type term struct {
+	text    string // 비교용 (소문자)
+	display string // 저장/표시용
+	inTitle bool
+}
+
+// (내부 함수) 제목/본문에서 단어(조사 제거)와 인접 두 단어 구를 추출
+func docTerms(doc Document) []term {
+	var terms []term
+	terms = append(terms, textTerms(doc.Title, true)...)
+	terms = append(terms, textTerms(truncateRunes(cleanText(doc.Body), maxBodyRunes), false)...)
+	return terms
+}
+
+func textTerms(text string, inTitle bool) []term {
+	words := splitWords(cleanText(text))
+
+	var terms []term
+	prev := ""
+	for _, w := range words {
+		stem := stripParticle(w)
+		if !isKeywordCandidate(stem) {
+			prev = ""
+			continue
+		}
+		lower := strings.ToLower(stem)
+		terms = append(terms, term{text: lower, display: stem, inTitle: inTitle})
+
+		// 인접한 두 단어 구 (예: "반도체 수출", "금리 인하")
+		if prev != "" {
+			terms = append(terms, term{
+				text:    strings.ToLower(prev) + " " + lower,
+				display: prev + " " + stem,
+				inTitle: inTitle,
+			})
+		}
+		// 조사가 붙어 있던 단어 뒤에서는 구를 끊음 (다른 문장 성분)
+		if stem != w {
+			prev = ""
+		} else {
+			prev = stem
+		}
+	}
+	return terms
+}
+
+// (헬퍼 함수) HTML 태그/엔티티 제거
+func cleanText(text string) string {
+	return html.UnescapeString(htmlTagPattern.ReplaceAllString(text, " "))
+}
+
+// (헬퍼 함수) 글자/숫자가 아닌 문자로 어절 분리
+func splitWords(text string) []string {
+	return strings.FieldsFunc(text, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
+
+func truncateRunes(text string, max int) string {
+	if utf8.RuneCountInString(text) <= max {
+		return text
+	}
+	return string([]rune(text)[:max])
+}
+
+// 어절 끝에서 제거할 조사/어미 (긴 것부터 검사)
+var particleSuffixes = []string{
+	"에서는", "으로는", "에게서", "이라고", "이라는", "으로서", "으로써", "에서도", "까지는",
+	"에서", "으로", "에게", "까지", "부터", "처럼", "보다", "이나", "이며", "이고", "에는", "에도",
+	"와의", "과의", "로서", "로써", "라고", "라는", "이다", "였다", "했다", "한다", "하는", "하며",
+	"했고", "된다", "됐다", "되는", "들은", "들이", "들을", "들의",
+	"은", "는", "이", "가", "을", "를", "의", "에", "와", "과", "도", "만", "로",
+}
+
+// (내부 함수) 조사 제거 (남는 부분이 2글자 이상일 때만, 사전에 있는 개체명은 그대로)
+func stripParticle(word string) string {
+	if isKnownEntity(word) {
+		return word
+	}
+	for _, suffix := range particleSuffixes {
+		if strings.HasSuffix(word, suffix) {
+			stem := strings.TrimSuffix(word, suffix)
+			if utf8.RuneCountInString(stem) >= 2 {
+				return stem
+			}
+		}
+	}
+	return word
+}
+
+// 동사/형용사 활용형으로 보이는 어절 끝 (예: "방문해", "발표했", "감소할")
+var verbEndings = []string{"해", "했", "하", "돼", "됐", "될", "할"}
+
+// (내부 함수) 키워드 후보 조건: 2글자 이상, 숫자로 시작하지 않음, 불용어/직함/활용형 아님
+func isKeywordCandidate(word string) bool {
+	length := utf8.RuneCountInString(word)
+	if length < 2 {
+		return false
+	}
+	first, _ := utf8.DecodeRuneInString(word)
+	if unicode.IsDigit(first) {
+		return false
+	}
+	lower := strings.ToLower(word)
+	if stopwords[lower] || personTitles[lower] {
+		return false
+	}
+	if length >= 3 {
+		for _, ending := range verbEndings {
+			if strings.HasSuffix(word, ending) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// 뉴스에서 흔하지만 주제를 나타내지 않는 단어
+var stopwords = map[string]bool{
+	"기자": true, "뉴스": true, "무단": true, "배포": true, "금지": true, "전재": true, "재배포": true, "저작권자": true,
+	"오늘": true, "어제": true, "내일": true, "지난": true, "이번": true, "올해": true, "지난해": true, "내년": true, "이날": true,
+	"관련": true, "대한": true, "위해": true, "통해": true, "따르면": true, "밝혔다": true, "말했다": true, "전했다": true,
+	"것으로": true, "있다": true, "없다": true, "했다": true, "한다": true, "있는": true, "없는": true, "같은": true,
+	"가운데": true, "가장": true, "또한": true, "그러나": true, "하지만": true, "최근": true, "현재": true, "이후": true,
+	"이전": true, "경우": true, "대해": true, "위한": true, "것이": true, "것은": true, "등을": true, "이라며": true,
+	"오전": true, "오후": true, "이상": true, "이하": true, "정도": true, "예정": true, "계획": true, "상황": true,
+	"the": true, "and": true, "for": true, "with": true, "that": true, "this": true,
+}
@@ -0,0 +1,182 @@
+package tagger
+
+import "testing"
+
+func TestStripParticle(t *testing.T) {
+	tests := []struct {
+		word string
+		want string
+	}{
+		{"정부는", "정부"},
+		{"반도체를", "반도체"},
+		{"서울에서", "서울"},
+		{"국회에서는", "국회"},
+		{"금리가", "금리"},
+		{"수출이", "수출"},
+		{"한국으로", "한국"},
+		{"기업들의", "기업"},
+		{"발표했다", "발표"},
+		{"물가", "물가"},   // 조사 없음
+		{"나는", "나는"},   // 남는 부분이 1글자면 그대로
+		{"이란", "이란"},   // 장소 사전에 있는 단어는 그대로 ("이"+"란" 아님)
+		{"세종", "세종"},   // 사전 단어
+		{"카카오", "카카오"}, // 사전 단어 ("오"는 조사가 아님)
+		{"더불어민주당", "더불어민주당"},
+	}
+
+	for _, tt := range tests {
+		if got := stripParticle(tt.word); got != tt.want {
+			t.Errorf("stripParticle(%q) = %q, want %q", tt.word, got, tt.want)
+		}
+	}
+}
+
+func TestIsKeywordCandidate(t *testing.T) {
+	tests := []struct {
+		word string
+		want bool
+	}{
+		{"반도체", true},
+		{"금리", true},
+		{"AI", true},
+		{"가", false},     // 1글자
+		{"2025년", false}, // 숫자로 시작
+		{"기자", false},    // 불용어
+		{"THE", false},   // 불용어 (대소문자 무시)
+		{"장관", false},    // 직함
+		{"방문해", false},   // 활용형
+		{"발표했", false},   // 활용형
+		{"해", false},     // 1글자
+		{"피해", true},     // 2글자는 활용형 검사 안 함
+	}
+
+	for _, tt := range tests {
+		if got := isKeywordCandidate(tt.word); got != tt.want {
+			t.Errorf("isKeywordCandidate(%q) = %v, want %v", tt.word, got, tt.want)
+		}
+	}
+}
+
+func TestExtractEntities(t *testing.T) {
+	tests := []struct {
+		name string
+		doc  Document
+		want map[string]string // 이름 → 종류 (반드시 포함)
+		not  []string          // 포함되면 안 되는 이름
+	}{
+		{
+			name: "인물 + 직함",
+			doc:  Document{Title: "홍길동 장관, 반도체 지원 발표", Body: "홍길동 장관은 이날 기자회견에서 지원책을 밝혔다."},
+			want: map[string]string{"홍길동": TypePerson},
+			not:  []string{"장관"},
+		},
+		{
+			name: "전 직함",
+			doc:  Document{Title: "김철수 전 대표 출마 선언"},
+			want: map[string]string{"김철수": TypePerson},
+		},
+		{
+			name: "성씨가 아니면 인물 아님",
+			doc:  Document{Title: "국정감사 장관 출석"},
+			not:  []string{"국정감"},
+		},
+		{
+			name: "정당명 뒤 직함은 인물 아님",
+			doc:  Document{Title: "더불어민주당 대표 회견"},
+			want: map[string]string{"더불어민주당": TypeOrganization},
+			not:  []string{"민주당"},
+		},
+		{
+			name: "사전 기관/장소 + 조사",
+			doc:  Document{Title: "한국은행이 금리 동결", Body: "미국과 중국의 무역 갈등 속에 서울에서 회의가 열렸다."},
+			want: map[string]string{"한국은행": TypeOrganization, "미국": TypePlace, "중국": TypePlace, "서울": TypePlace},
+		},
+		{
+			name: "기관 접미사",
+			doc:  Document{Title: "방송통신위원회, 새 규정 발표", Body: "미래전자는 신제품을 공개했다."},
+			want: map[string]string{"방송통신위원회": TypeOrganization, "미래전자": TypeOrganization},
+			not:  []string{"위원회"},
+		},
+		{
+			name: "영문 사전 기관 (대소문자 무시)",
+			doc:  Document{Title: "nato 정상회의 개막"},
+			want: map[string]string{"NATO": TypeOrganization},
+		},
+		{
+			name: "HTML 태그/엔티티 제거",
+			doc:  Document{Title: "<b>일본</b>&amp;중국 외교"},
+			want: map[string]string{"일본": TypePlace, "중국": TypePlace},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := make(map[string]string)
+			for _, tag := range extractEntities(tt.doc, 10) {
+				got[tag.Name] = tag.Type
+			}
+			for name, typ := range tt.want {
+				if got[name] != typ {
+					t.Errorf("entity %q type = %q, want %q (all: %v)", name, got[name], typ, got)
+				}
+			}
+			for _, name := range tt.not {
+				if _, ok := got[name]; ok {
+					t.Errorf("unexpected entity %q (all: %v)", name, got)
+				}
+			}
+		})
+	}
+}
+
+func TestTags(t *testing.T) {
+	corpus := NewCorpus([]Document{
+		{Title: "정부 부동산 대책 발표", Body: "정부는 부동산 대책을 발표했다."},
+		{Title: "정부 예산안 국회 제출", Body: "정부는 예산안을 국회에 제출했다."},
+		{Title: "프로야구 개막", Body: "프로야구가 개막했다."},
+	})
+
+	doc := Document{
+		Title: "홍길동 장관, 반도체 수출 지원 확대",
+		Body:  "홍길동 장관은 반도체 수출 지원을 확대한다고 밝혔다. 정부는 반도체 수출 기업에 세제 혜택을 준다. 미국 수출 규제도 논의했다.",
+	}
+	tags := corpus.Tags(doc, 3, 3)
+
+	byName := make(map[string]Tag)
+	keywords := 0
+	for _, tag := range tags {
+		byName[tag.Name] = tag
+		if tag.Type == TypeKeyword {
+			keywords++
+		}
+		if tag.Score <= 0 || tag.Score > 1 {
+			t.Errorf("tag %q score = %v, want (0, 1]", tag.Name, tag.Score)
+		}
+	}
+
+	if byName["홍길동"].Type != TypePerson {
+		t.Errorf("홍길동 not tagged as person: %+v", tags)
+	}
+	if byName["미국"].Type != TypePlace {
+		t.Errorf("미국 not tagged as place: %+v", tags)
+	}
+	if keywords == 0 || keywords > 3 {
+		t.Errorf("keyword count = %d, want 1..3: %+v", keywords, tags)
+	}
+	// 개체명/직함/불용어/조사 붙은 형태는 키워드로 나오지 않음
+	for _, name := range []string{"장관", "장관은", "밝혔다", "정부는", "반도체를"} {
+		if _, ok := byName[name]; ok {
+			t.Errorf("unexpected tag %q: %+v", name, tags)
+		}
+	}
+	// 제목과 본문에 반복된 주제어가 가장 먼저 선택됨 (구 또는 단어)
+	found := false
+	for _, name := range []string{"반도체", "반도체 수출", "수출", "수출 지원"} {
+		if tag, ok := byName[name]; ok && tag.Type == TypeKeyword {
+			found = true
+		}
+	}
+	if !found {
+		t.Errorf("반도체/수출 keyword missing: %+v", tags)
+	}
+}
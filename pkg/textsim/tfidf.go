@@ -0,0 +1,131 @@
+package textsim
+
+import (
+	"html"
+	"math"
+	"regexp"
+	"strings"
+	"unicode"
+)
+
+// 문서 1개당 분석에 사용하는 최대 글자 수 (긴 본문으로 인한 계산량 폭증 방지)
+const maxDocRunes = 2000
+
+// Vector: 단어(토큰) → 가중치 희소 벡터
+type Vector map[string]float64
+
+var htmlTagPattern = regexp.MustCompile(`<[^>]*>`)
+
+// 의미 없는 빈출 토큰
+var stopwords = map[string]bool{
+	"the": true, "and": true, "for": true, "with": true, "that": true, "this": true,
+	"기자": true, "뉴스": true, "무단": true, "배포": true, "금지": true, "전재": true,
+}
+
+// === 토큰화 ===
+// 한국어는 형태소 분석기 없이도 조사 변화에 강하도록 음절 2-gram으로,
+// 그 외(영문/숫자)는 단어 단위로 자름
+func Tokenize(text string) []string {
+	text = html.UnescapeString(htmlTagPattern.ReplaceAllString(text, " "))
+	if r := []rune(text); len(r) > maxDocRunes {
+		text = string(r[:maxDocRunes])
+	}
+
+	words := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+
+	tokens := make([]string, 0, len(words)*2)
+	for _, w := range words {
+		if stopwords[w] {
+			continue
+		}
+		runes := []rune(w)
+		if len(runes) < 2 {
+			continue
+		}
+
+		if unicode.Is(unicode.Hangul, runes[0]) {
+			for i := 0; i+1 < len(runes); i++ {
+				bigram := string(runes[i : i+2])
+				if !stopwords[bigram] {
+					tokens = append(tokens, bigram)
+				}
+			}
+			continue
+		}
+		tokens = append(tokens, w)
+	}
+	return tokens
+}
+
+// === TF-IDF 벡터 계산 ===
+// docs 전체를 말뭉치로 보고 IDF를 계산한 뒤, 문서별 L2 정규화된 벡터를 반환
+//   - TF: 1 + log(tf) (긴 문서에서 반복되는 단어의 영향 완화)
+//   - IDF: log((N+1)/(df+1)) + 1
+func BuildTFIDF(docs []string) []Vector {
+	termCounts := make([]map[string]int, len(docs))
+	df := make(map[string]int)
+
+	for i, doc := range docs {
+		counts := make(map[string]int)
+		for _, t := range Tokenize(doc) {
+			counts[t]++
+		}
+		for t := range counts {
+			df[t]++
+		}
+		termCounts[i] = counts
+	}
+
+	n := float64(len(docs))
+	vectors := make([]Vector, len(docs))
+	for i, counts := range termCounts {
+		vec := make(Vector, len(counts))
+		for t, tf := range counts {
+			idf := math.Log((n+1)/(float64(df[t])+1)) + 1
+			vec[t] = (1 + math.Log(float64(tf))) * idf
+		}
+		vectors[i] = Normalize(vec)
+	}
+	return vectors
+}
+
+// L2 정규화 (영벡터는 그대로 반환)
+func Normalize(v Vector) Vector {
+	var sum float64
+	for _, w := range v {
+		sum += w * w
+	}
+	if sum == 0 {
+		return v
+	}
+	norm := math.Sqrt(sum)
+	for t, w := range v {
+		v[t] = w / norm
+	}
+	return v
+}
+
+// 여러 벡터의 평균(중심) 벡터 (정규화하여 반환)
+func Centroid(vectors []Vector) Vector {
+	centroid := make(Vector)
+	for _, v := range vectors {
+		for t, w := range v {
+			centroid[t] += w
+		}
+	}
+	return Normalize(centroid)
+}
+
+// 코사인 유사도 (두 벡터 모두 정규화되어 있다고 가정)
+func Cosine(a, b Vector) float64 {
+	if len(a) > len(b) {
+		a, b = b, a
+	}
+	var dot float64
+	for t, w := range a {
+		dot += w * b[t]
+	}
+	return dot
+}
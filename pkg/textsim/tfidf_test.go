@@ -0,0 +1,95 @@
+package textsim
+
+import (
+	"math"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestTokenize(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want []string
+	}{
+		{"빈 문자열", "", []string{}},
+		{"한글은 음절 2-gram", "반도체", []string{"반도", "도체"}},
+		{"조사가 붙어도 공통 2-gram 유지", "금리를", []string{"금리", "리를"}},
+		{"영문은 소문자 단어", "Apple iPhone", []string{"apple", "iphone"}},
+		{"1글자 단어 제외", "a 나 Go", []string{"go"}},
+		{"불용어 제외 (단어/2-gram)", "the 기자 뉴스룸", []string{"스룸"}},
+		{"HTML 태그/엔티티 제거", "<b>AI</b>&amp;ML", []string{"ai", "ml"}},
+		{"문장부호로 분리", "서울,부산", []string{"서울", "부산"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Tokenize(tt.text); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Tokenize(%q) = %q, want %q", tt.text, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTokenizeTruncatesLongText(t *testing.T) {
+	tokens := Tokenize(strings.Repeat("ab ", maxDocRunes))
+	// 3글자 단위 반복이므로 최대 글자 수 안에 든 단어만 토큰이 됨
+	if want := maxDocRunes / 3; len(tokens) > want+1 {
+		t.Errorf("len(tokens) = %d, want <= %d", len(tokens), want+1)
+	}
+}
+
+func TestBuildTFIDF(t *testing.T) {
+	vectors := BuildTFIDF([]string{
+		"반도체 수출 증가",
+		"반도체 수출 감소",
+		"프로야구 개막전",
+		"",
+	})
+	if len(vectors) != 4 {
+		t.Fatalf("len(vectors) = %d, want 4", len(vectors))
+	}
+
+	for i, v := range vectors[:3] {
+		if norm := math.Sqrt(Cosine(v, v)); math.Abs(norm-1) > 1e-9 {
+			t.Errorf("vectors[%d] norm = %v, want 1", i, norm)
+		}
+	}
+	if len(vectors[3]) != 0 {
+		t.Errorf("empty document vector = %v, want empty", vectors[3])
+	}
+
+	similar := Cosine(vectors[0], vectors[1])
+	different := Cosine(vectors[0], vectors[2])
+	if similar <= different {
+		t.Errorf("Cosine(similar) = %v, want > Cosine(different) = %v", similar, different)
+	}
+	if different != 0 {
+		t.Errorf("Cosine(no shared tokens) = %v, want 0", different)
+	}
+
+	// 흔한 토큰(반도/도체)은 드문 토큰(증가)보다 가중치가 낮음
+	if vectors[0]["반도"] >= vectors[0]["증가"] {
+		t.Errorf("weight(반도) = %v, want < weight(증가) = %v", vectors[0]["반도"], vectors[0]["증가"])
+	}
+}
+
+func TestNormalizeAndCentroid(t *testing.T) {
+	v := Normalize(Vector{"a": 3, "b": 4})
+	if math.Abs(v["a"]-0.6) > 1e-9 || math.Abs(v["b"]-0.8) > 1e-9 {
+		t.Errorf("Normalize = %v, want a=0.6 b=0.8", v)
+	}
+	if zero := Normalize(Vector{}); len(zero) != 0 {
+		t.Errorf("Normalize(empty) = %v, want empty", zero)
+	}
+
+	c := Centroid([]Vector{{"a": 1}, {"b": 1}})
+	want := 1 / math.Sqrt2
+	if math.Abs(c["a"]-want) > 1e-9 || math.Abs(c["b"]-want) > 1e-9 {
+		t.Errorf("Centroid = %v, want a=b=%v", c, want)
+	}
+	if got := Cosine(Vector{"a": 1}, Vector{"b": 1}); got != 0 {
+		t.Errorf("Cosine(orthogonal) = %v, want 0", got)
+	}
+}